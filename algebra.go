@@ -0,0 +1,121 @@
+package cyclicKey
+
+// Key algebra
+//
+// A key contributes the exponent k32 = (k+1)*m to each position of the key
+// product, where m is the rotation multiplier for that position. An inverted
+// key contributes -(k+1)*m. Because every rotation multiplier is shared by all
+// keys, the effect of a key is linear in its signed exponents, (k+1) or -(k+1),
+// taken mod (p-1). Applying two keys is the same as applying one key whose
+// exponents are the sum, and a set of keys cycles when its exponents sum to 0.
+
+// exponents returns the signed exponent of each symbol of k, mod s.
+func (g *Group) exponents(k Key) []uint32 {
+	e := make([]uint32, g.keyLen(k.Data))
+	for j := range e {
+		e[j] = (g.symbol(k.Data, j) + 1) % g.s
+		if k.Invert {
+			e[j] = (g.s - e[j]) % g.s
+		}
+	}
+	return e
+}
+
+// fromExponents returns the non-inverted key with the signed exponents e.
+func (g *Group) fromExponents(e []uint32) Key {
+	k := Key{
+		Data: make([]byte, len(e)*g.width),
+	}
+	for j, v := range e {
+		// v is k+1 mod s, so v == 0 is stored as s-1
+		v = (v%g.s + g.s - 1) % g.s
+		for b := 0; b < g.width; b++ {
+			k.Data[j*g.width+b] = byte(v >> (8 * uint(b)))
+		}
+	}
+	return k
+}
+
+// withInvert returns a key with the same effect as k and the given inversion
+// flag.
+func (g *Group) withInvert(k Key, invert bool) Key {
+	if k.Invert == invert {
+		return k
+	}
+	e := g.exponents(k)
+	for j := range e {
+		e[j] = (g.s - e[j]) % g.s
+	}
+	k = g.fromExponents(e)
+	k.Invert = invert
+	return k
+}
+
+// sumExponents adds the signed exponents of keys, mod s.
+func (g *Group) sumExponents(keys []Key) []uint32 {
+	if len(keys) == 0 {
+		panic("cyclicKey: no keys")
+	}
+	sum := make([]uint32, g.keyLen(keys[0].Data))
+	for _, k := range keys {
+		if len(k.Data) != len(keys[0].Data) {
+			panic("cyclicKey: keys have different lengths")
+		}
+		for j, e := range g.exponents(k) {
+			sum[j] = (sum[j] + e) % g.s
+		}
+	}
+	return sum
+}
+
+// complete returns the key whose exponents are the negated sum of keys.
+func (g *Group) complete(keys []Key) Key {
+	sum := g.sumExponents(keys)
+	for j := range sum {
+		sum[j] = (g.s - sum[j]) % g.s
+	}
+	return g.fromExponents(sum)
+}
+
+// Combine returns a single key equal to applying both a and b.
+func Combine(a, b Key) Key {
+	return defaultParams.Combine(a, b)
+}
+
+// Combine is Combine under the parameter set.
+func (pr *Params) Combine(a, b Key) Key {
+	g := pr.group()
+	return g.fromExponents(g.sumExponents([]Key{a, b}))
+}
+
+// Negate returns the key that undoes k: the same key bytes with the inversion
+// flag flipped. Applying k and then Negate(k) returns the original message.
+// This holds under any parameter set.
+func Negate(k Key) Key {
+	return Key{
+		Data:   append([]byte(nil), k.Data...),
+		Invert: !k.Invert,
+	}
+}
+
+// Delta returns the key that turns a message state with a applied into the
+// same message with b applied instead.
+func Delta(a, b Key) Key {
+	return defaultParams.Delta(a, b)
+}
+
+// Delta is Delta under the parameter set.
+func (pr *Params) Delta(a, b Key) Key {
+	return pr.Combine(Negate(a), b)
+}
+
+// Complete returns the key that closes the cycle for keys: applying all of
+// keys and the returned key, in any order, returns the original message.
+func Complete(keys ...Key) Key {
+	return defaultParams.Complete(keys...)
+}
+
+// Complete is Complete under the parameter set.
+func (pr *Params) Complete(keys ...Key) Key {
+	return pr.group().complete(keys)
+}
@@ -0,0 +1,75 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func randomKey(kl int, invert bool) Key {
+	k := Key{
+		Data:   make([]byte, kl),
+		Invert: invert,
+	}
+	rand.Read(k.Data)
+	return k
+}
+
+func TestCombine(t *testing.T) {
+	m := make([]byte, 2000)
+	rand.Read(m)
+	for _, flags := range [][2]bool{{false, false}, {false, true}, {true, true}} {
+		a, b := randomKey(10, flags[0]), randomKey(10, flags[1])
+		if !bytes.Equal(b.Apply(a.Apply(m)), Combine(a, b).Apply(m)) {
+			t.Error("Combine does not match applying both keys", flags)
+		}
+	}
+}
+
+func TestNegate(t *testing.T) {
+	m := make([]byte, 2000)
+	rand.Read(m)
+	k := randomKey(10, false)
+	if !bytes.Equal(m, Negate(k).Apply(k.Apply(m))) {
+		t.Error("Negate did not undo the key")
+	}
+	if !bytes.Equal(k.Apply(m), Negate(Negate(k)).Apply(m)) {
+		t.Error("Double negation changed the key")
+	}
+}
+
+func TestDelta(t *testing.T) {
+	m := make([]byte, 2000)
+	rand.Read(m)
+	a, b := randomKey(10, true), randomKey(10, false)
+	if !bytes.Equal(b.Apply(m), Delta(a, b).Apply(a.Apply(m))) {
+		t.Error("Delta did not move the state from a to b")
+	}
+}
+
+func TestComplete(t *testing.T) {
+	m := make([]byte, 2000)
+	rand.Read(m)
+	keys := Keyset{randomKey(10, false), randomKey(10, true), randomKey(10, true)}
+	keys = append(keys, Complete(keys...))
+	if !bytes.Equal(m, CipherMany(m, keys)) {
+		t.Error("Completed keyset did not cycle")
+	}
+
+	// Complete recovers any missing key of a keyset, up to its inversion flag
+	ks := RandomKeyset(4)
+	got := Group257.withInvert(Complete(ks[0], ks[2], ks[3]), ks[1].Invert)
+	if !bytes.Equal(got.Data, ks[1].Data) {
+		t.Error("Complete did not recover the missing key")
+	}
+}
+
+func TestWithInvert(t *testing.T) {
+	m := make([]byte, 500)
+	rand.Read(m)
+	k := randomKey(10, false)
+	f := Group257.withInvert(k, true)
+	if !f.Invert || !bytes.Equal(k.Apply(m), f.Apply(m)) {
+		t.Error("withInvert changed the effect of the key")
+	}
+}
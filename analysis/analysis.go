@@ -0,0 +1,277 @@
+// Package analysis runs statistical tests on the output of cyclicKey: the
+// ciphertext from Cipher and the stream of key products it multiplies the
+// message by. Neither says anything about security on its own, the attack
+// package breaks keys that pass every test here, but they show where the
+// output is measurably far from random and let that be tracked over time.
+package analysis
+
+import (
+	"errors"
+	"io"
+	"math"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+// period is the length of the root queue. The root index wraps every period
+// positions, so the same roots come back with only the rotation changed.
+const period = 128
+
+// Stats are the results of the tests on one byte stream. Every test has a
+// statistic and, where it has a known distribution for random data, a z-score
+// or p-value, so that a single number can be watched over time.
+type Stats struct {
+	Bytes int `json:"bytes"`
+	// ChiSquare is the chi-square statistic of the byte frequencies against a
+	// uniform distribution, with 255 degrees of freedom. ChiSquareP is the
+	// probability of a statistic at least as large from random data.
+	ChiSquare  float64 `json:"chi_square"`
+	ChiSquareP float64 `json:"chi_square_p"`
+	// Entropy is the Shannon entropy of the byte frequencies in bits per byte
+	Entropy float64 `json:"entropy"`
+	// SerialCorrelation is the correlation between each byte and the next,
+	// near 0 for random data
+	SerialCorrelation float64 `json:"serial_correlation"`
+	// Runs is the number of runs of bytes above and below 127.5, and RunsZ its
+	// z-score under the Wald-Wolfowitz test. RunsZ is 0 when the z-score is
+	// undefined, as it is when every byte is on the same side.
+	Runs  int     `json:"runs"`
+	RunsZ float64 `json:"runs_z"`
+	// PeriodRepeats is the fraction of positions whose byte equals the byte
+	// 128 positions later, 1/256 for random data, and PeriodRepeatsZ its
+	// z-score
+	PeriodRepeats  float64 `json:"period_repeats"`
+	PeriodRepeatsZ float64 `json:"period_repeats_z"`
+}
+
+// Analyze runs every test on data.
+func Analyze(data []byte) Stats {
+	st := Stats{Bytes: len(data)}
+	if len(data) < 2 {
+		return st
+	}
+	n := float64(len(data))
+
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+	expected := n / 256
+	for _, c := range counts {
+		d := float64(c) - expected
+		st.ChiSquare += d * d / expected
+		if c > 0 {
+			f := float64(c) / n
+			st.Entropy -= f * math.Log2(f)
+		}
+	}
+	st.ChiSquareP = chiSquareP(st.ChiSquare, 255)
+
+	st.SerialCorrelation = serialCorrelation(data)
+	st.Runs, st.RunsZ = runs(data)
+
+	if len(data) > period {
+		repeats := 0
+		for i := period; i < len(data); i++ {
+			if data[i] == data[i-period] {
+				repeats++
+			}
+		}
+		m := float64(len(data) - period)
+		st.PeriodRepeats = float64(repeats) / m
+		st.PeriodRepeatsZ = (float64(repeats) - m/256) / math.Sqrt(m*(1.0/256)*(255.0/256))
+	}
+	return st
+}
+
+// serialCorrelation is the correlation of each byte with the next, wrapping
+// the last byte around to the first, as ent computes it.
+func serialCorrelation(data []byte) float64 {
+	n := float64(len(data))
+	var sum, sumSq, sumProd float64
+	for i, b := range data {
+		x := float64(b)
+		y := float64(data[(i+1)%len(data)])
+		sum += x
+		sumSq += x * x
+		sumProd += x * y
+	}
+	d := n*sumSq - sum*sum
+	if d == 0 {
+		return 1
+	}
+	return (n*sumProd - sum*sum) / d
+}
+
+// runs counts the runs of bytes above and below 127.5 and their z-score.
+func runs(data []byte) (int, float64) {
+	r, above := 1, 0
+	for i, b := range data {
+		if b > 127 {
+			above++
+		}
+		if i > 0 && (b > 127) != (data[i-1] > 127) {
+			r++
+		}
+	}
+	n1, n2 := float64(above), float64(len(data)-above)
+	n := n1 + n2
+	mean := 2*n1*n2/n + 1
+	variance := 2 * n1 * n2 * (2*n1*n2 - n) / (n * n * (n - 1))
+	// with every byte on one side, or one byte on each, there is only one
+	// possible count and no variance
+	if !(variance > 0) {
+		return r, 0
+	}
+	return r, (float64(r) - mean) / math.Sqrt(variance)
+}
+
+// chiSquareP is the probability that a chi-square variable with k degrees of
+// freedom is at least x, the regularized upper incomplete gamma function
+// Q(k/2, x/2).
+func chiSquareP(x float64, k int) float64 {
+	return gammaQ(float64(k)/2, x/2)
+}
+
+func gammaQ(a, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	lg, _ := math.Lgamma(a)
+	if x < a+1 {
+		// series for P(a, x)
+		sum, term := 1/a, 1/a
+		for n := 1.0; n < 1000; n++ {
+			term *= x / (a + n)
+			sum += term
+			if term < sum*1e-15 {
+				break
+			}
+		}
+		return 1 - sum*math.Exp(-x+a*math.Log(x)-lg)
+	}
+	// continued fraction for Q(a, x), by the modified Lentz method
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1.0; i < 1000; i++ {
+		an := -i * (i - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < 1e-15 {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lg) * h
+}
+
+// Report is the result of a run, meant to be stored as JSON and compared from
+// one version of the cipher to the next.
+type Report struct {
+	KeyLength int       `json:"key_length"`
+	Size      int       `json:"size"`
+	Seeds     [4]uint32 `json:"seeds"`
+	// Ciphertext is the output of Cipher on the plaintext
+	Ciphertext Stats `json:"ciphertext"`
+	// KeyProducts is the stream of key products, less one so that they fit
+	// in a byte. It is the ciphertext of a message of zeros.
+	KeyProducts Stats `json:"key_products"`
+	// Unlinkability is the advantage of the distinguishers, if they were run
+	Unlinkability *UnlinkReport `json:"unlinkability,omitempty"`
+}
+
+// Config is what a run is made from.
+type Config struct {
+	// Params are the cipher parameters, the key length is Params.KeyLength.
+	// Only groups with one byte symbols can be analyzed.
+	Params cyclicKey.Params
+	// Size is the number of bytes of each stream to analyze
+	Size int
+	// Plaintext is repeated to fill Size bytes. It defaults to a line of
+	// English text, so the ciphertext stats show how much structure leaks.
+	Plaintext []byte
+	// Rand is the source the key is generated from, crypto/rand if nil
+	Rand io.Reader
+	// Samples is the number of key pairs and state pairs the unlinkability
+	// distinguishers are run on, 0 to skip them. The states are StateSize
+	// bytes, on routes of Hops keys.
+	Samples   int
+	StateSize int
+	Hops      int
+}
+
+// Errors returned by Run for a Config it can't run with
+var (
+	ErrSize      = errors.New("analysis: size can't be negative")
+	ErrSamples   = errors.New("analysis: number of samples can't be negative")
+	ErrStateSize = errors.New("analysis: state size can't be negative")
+	ErrHops      = errors.New("analysis: a route needs at least 1 hop")
+)
+
+// check rejects a Config Run can't run with.
+func (cfg Config) check() error {
+	switch {
+	case cfg.Size < 0:
+		return ErrSize
+	case cfg.Samples < 0:
+		return ErrSamples
+	case cfg.StateSize < 0:
+		return ErrStateSize
+	case cfg.Samples > 0 && cfg.Hops < 1:
+		return ErrHops
+	}
+	return nil
+}
+
+// DefaultPlaintext is the plaintext used when Config.Plaintext is empty.
+var DefaultPlaintext = []byte("The quick brown fox jumps over the lazy dog. ")
+
+// Run generates a key and analyzes the ciphertext and key products it
+// produces. It returns one of the errors above for a negative size or count.
+func Run(cfg Config) (*Report, error) {
+	if err := cfg.check(); err != nil {
+		return nil, err
+	}
+	pr := cfg.Params
+	keys, err := pr.NewKeyset(3, cfg.Rand)
+	if err != nil {
+		return nil, err
+	}
+	key := keys[0]
+
+	plain := cfg.Plaintext
+	if len(plain) == 0 {
+		plain = DefaultPlaintext
+	}
+	msg := make([]byte, cfg.Size)
+	for i := 0; i < len(msg); i += len(plain) {
+		copy(msg[i:], plain)
+	}
+
+	report := &Report{
+		KeyLength:   pr.KeyLength,
+		Size:        cfg.Size,
+		Seeds:       pr.Seeds,
+		Ciphertext:  Analyze(pr.Cipher(msg, key.Data, key.Invert)),
+		KeyProducts: Analyze(pr.Cipher(make([]byte, cfg.Size), key.Data, false)),
+	}
+	if cfg.Samples > 0 {
+		report.Unlinkability, err = Unlinkability(pr, cfg.Samples, cfg.StateSize, cfg.Hops, cfg.Rand)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,118 @@
+package analysis
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestChiSquareP(t *testing.T) {
+	// the median of chi-square with 2 degrees of freedom is 2 ln 2, and with
+	// 255 it is close to 254.33
+	if p := chiSquareP(2*math.Ln2, 2); math.Abs(p-0.5) > 1e-9 {
+		t.Error("Expected 0.5, got", p)
+	}
+	if p := chiSquareP(254.33, 255); math.Abs(p-0.5) > 1e-3 {
+		t.Error("Expected about 0.5, got", p)
+	}
+	if p := chiSquareP(400, 255); p > 1e-6 {
+		t.Error("Expected a tiny p-value, got", p)
+	}
+}
+
+func TestAnalyzeRandom(t *testing.T) {
+	data := make([]byte, 1<<16)
+	rand.Read(data)
+	st := Analyze(data)
+	if st.Entropy < 7.99 {
+		t.Error("Entropy too low for random data", st.Entropy)
+	}
+	if math.Abs(st.SerialCorrelation) > 0.02 {
+		t.Error("Serial correlation too high for random data", st.SerialCorrelation)
+	}
+	if math.Abs(st.RunsZ) > 5 || math.Abs(st.PeriodRepeatsZ) > 5 || st.ChiSquareP < 1e-6 {
+		t.Errorf("Random data failed: %+v", st)
+	}
+}
+
+func TestAnalyzeStructured(t *testing.T) {
+	// a stream that repeats every 128 bytes
+	data := make([]byte, 1<<14)
+	for i := range data {
+		data[i] = byte(i % period)
+	}
+	st := Analyze(data)
+	if st.PeriodRepeats != 1 {
+		t.Error("Expected every position to repeat, got", st.PeriodRepeats)
+	}
+	if st.SerialCorrelation < 0.9 || st.ChiSquareP > 1e-6 {
+		t.Errorf("Structured data passed: %+v", st)
+	}
+}
+
+func TestAnalyzeNoRunsVariance(t *testing.T) {
+	// every byte below 127.5, and one byte on each side, have no z-score, and
+	// the stats must still encode as JSON
+	for _, data := range [][]byte{make([]byte, 1000), {0, 200}} {
+		st := Analyze(data)
+		if st.RunsZ != 0 {
+			t.Error("Expected a runs z-score of 0, got", st.RunsZ)
+		}
+		if _, err := json.Marshal(st); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestRunBadConfig(t *testing.T) {
+	good := Config{
+		Params:    cyclicKey.DefaultParams(),
+		Size:      10,
+		StateSize: 16,
+		Hops:      2,
+	}
+	tests := []struct {
+		set func(*Config)
+		err error
+	}{
+		{func(c *Config) { c.Size = -1 }, ErrSize},
+		{func(c *Config) { c.Samples = -1 }, ErrSamples},
+		{func(c *Config) { c.StateSize = -1 }, ErrStateSize},
+		{func(c *Config) { c.Samples, c.Hops = 1, 0 }, ErrHops},
+	}
+	for i, tc := range tests {
+		cfg := good
+		tc.set(&cfg)
+		if _, err := Run(cfg); err != tc.err {
+			t.Error("Config", i, "expected", tc.err, "got", err)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	pr := cyclicKey.DefaultParams()
+	cfg := Config{
+		Params: pr,
+		Size:   10000,
+		Rand:   mrand.New(mrand.NewSource(1)),
+	}
+	a, err := Run(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Rand = mrand.New(mrand.NewSource(1))
+	b, err := Run(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *a != *b {
+		t.Error("Same source produced different reports")
+	}
+	if a.KeyLength != pr.KeyLength || a.KeyProducts.Bytes != 10000 {
+		t.Errorf("Bad report: %+v", a)
+	}
+}
@@ -0,0 +1,268 @@
+package analysis
+
+import (
+	"crypto/rand"
+	"io"
+	"math"
+
+	"github.com/AdamColton/cyclicKey"
+	"github.com/AdamColton/cyclicKey/attack"
+)
+
+// Sample is a pair of keys or message states, and whether they are linked:
+// two keys from the same keyset, or two states of the same message.
+type Sample struct {
+	A, B   []byte
+	Linked bool
+}
+
+// Distinguisher guesses whether the two halves of a sample are linked.
+type Distinguisher struct {
+	Name   string
+	Linked func(pr cyclicKey.Params, a, b []byte) bool
+}
+
+// Result is how well a distinguisher did on a set of samples. Advantage is
+// the rate it called linked samples linked less the rate it called unlinked
+// samples linked: 0 means it learned nothing, 1 that it was always right.
+type Result struct {
+	Name          string  `json:"name"`
+	TruePositive  float64 `json:"true_positive"`
+	FalsePositive float64 `json:"false_positive"`
+	Advantage     float64 `json:"advantage"`
+}
+
+// Evaluate runs each distinguisher on every sample.
+func Evaluate(pr cyclicKey.Params, samples []Sample, ds []Distinguisher) []Result {
+	out := make([]Result, len(ds))
+	for i, d := range ds {
+		var linked, unlinked, tp, fp int
+		for _, s := range samples {
+			guess := d.Linked(pr, s.A, s.B)
+			if s.Linked {
+				linked++
+				if guess {
+					tp++
+				}
+			} else {
+				unlinked++
+				if guess {
+					fp++
+				}
+			}
+		}
+		r := Result{Name: d.Name}
+		if linked > 0 {
+			r.TruePositive = float64(tp) / float64(linked)
+		}
+		if unlinked > 0 {
+			r.FalsePositive = float64(fp) / float64(unlinked)
+		}
+		r.Advantage = r.TruePositive - r.FalsePositive
+		out[i] = r
+	}
+	return out
+}
+
+// KeySamples returns n pairs of keys, alternately two keys from one keyset of
+// 3 and two keys from different keysets. A negative n returns ErrSamples.
+func KeySamples(pr cyclicKey.Params, n int, rnd io.Reader) ([]Sample, error) {
+	if n < 0 {
+		return nil, ErrSamples
+	}
+	samples := make([]Sample, n)
+	for i := range samples {
+		a, err := pr.NewKeyset(3, rnd)
+		if err != nil {
+			return nil, err
+		}
+		s := Sample{A: a[0].Data, B: a[1].Data, Linked: i%2 == 0}
+		if !s.Linked {
+			b, err := pr.NewKeyset(3, rnd)
+			if err != nil {
+				return nil, err
+			}
+			s.B = b[1].Data
+		}
+		samples[i] = s
+	}
+	return samples, nil
+}
+
+// StateSamples returns n pairs of message states of size bytes. Linked pairs
+// are the same message at two points on a route of hops keys, unlinked pairs
+// are states of two different messages. The messages are random, so only the
+// keys can link them. A negative n or size returns ErrSamples or ErrStateSize.
+func StateSamples(pr cyclicKey.Params, n, size, hops int, rnd io.Reader) ([]Sample, error) {
+	switch {
+	case n < 0:
+		return nil, ErrSamples
+	case size < 0:
+		return nil, ErrStateSize
+	}
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	if hops < 2 {
+		hops = 2
+	}
+	samples := make([]Sample, n)
+	for i := range samples {
+		m := make([]byte, size)
+		if _, err := io.ReadFull(rnd, m); err != nil {
+			return nil, &cyclicKey.EntropyError{Err: err}
+		}
+		keys, err := pr.NewKeyset(hops+1, rnd)
+		if err != nil {
+			return nil, err
+		}
+		// the states seen after the first j and the first k hops
+		var b [2]byte
+		if _, err := io.ReadFull(rnd, b[:]); err != nil {
+			return nil, &cyclicKey.EntropyError{Err: err}
+		}
+		j := int(b[0]) % hops
+		k := j + 1 + int(b[1])%(hops-j)
+		states := make([][]byte, k+1)
+		states[0] = m
+		for h := 0; h < k; h++ {
+			states[h+1] = pr.Cipher(states[h], keys[h].Data, keys[h].Invert)
+		}
+		s := Sample{A: states[j], B: states[k], Linked: i%2 == 0}
+		if !s.Linked {
+			other := make([]byte, size)
+			if _, err := io.ReadFull(rnd, other); err != nil {
+				return nil, &cyclicKey.EntropyError{Err: err}
+			}
+			ks, err := pr.NewKeyset(3, rnd)
+			if err != nil {
+				return nil, err
+			}
+			s.A = pr.Cipher(other, ks[0].Data, ks[0].Invert)
+		}
+		samples[i] = s
+	}
+	return samples, nil
+}
+
+// KeyDistinguishers are the distinguishers that work on pairs of keys.
+var KeyDistinguishers = []Distinguisher{
+	{Name: "equal_bytes", Linked: equalBytes},
+	{Name: "exponent_sum", Linked: exponentSum},
+}
+
+// StateDistinguishers are the distinguishers that work on pairs of message
+// states.
+var StateDistinguishers = []Distinguisher{
+	{Name: "equal_bytes", Linked: equalBytes},
+	{Name: "ratio_frequency", Linked: ratioFrequency},
+	{Name: "dlog_linearization", Linked: dlogLinearization},
+}
+
+// equalBytes calls a pair linked when more bytes match position for position
+// than random data would give, by 3 standard deviations.
+func equalBytes(pr cyclicKey.Params, a, b []byte) bool {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	eq := 0
+	for i := 0; i < n; i++ {
+		if a[i] == b[i] {
+			eq++
+		}
+	}
+	mean := float64(n) / 256
+	return float64(eq) > mean+3*math.Sqrt(mean*255/256)
+}
+
+// exponentSum calls a pair of keys linked when the sum of their exponents is
+// further from uniform than random keys would be. In a keyset of 3 the third
+// key is the negated sum, so this is where a bias in Complete would show.
+func exponentSum(pr cyclicKey.Params, a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sum := make([]byte, len(a))
+	for i := range a {
+		sum[i] = a[i] + b[i] + 2
+	}
+	return chiSquareP(chiSquare(sum), 255) < 0.01
+}
+
+// ratioFrequency calls a pair of states linked when the byte-wise ratio b/a,
+// which is the key product stream for a linked pair, fails the frequency test.
+func ratioFrequency(pr cyclicKey.Params, a, b []byte) bool {
+	return chiSquareP(chiSquare(ratios(a, b)), 255) < 0.01
+}
+
+// dlogLinearization calls a pair of states linked when the discrete logs of
+// the byte-wise ratios fit the linear system of a single key. Any number of
+// hops combine into one key, so this holds for every linked pair, and random
+// ratios contradict the system within a rotation.
+func dlogLinearization(pr cyclicKey.Params, a, b []byte) bool {
+	sv, err := attack.NewSolver(pr, pr.KeyLength)
+	if err != nil || len(a) != len(b) {
+		return false
+	}
+	return sv.Add(attack.Pair{Plain: a, Cipher: b}) == nil
+}
+
+// ratios returns (b+1)/(a+1) - 1 mod 257 for each byte.
+func ratios(a, b []byte) []byte {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	g := cyclicKey.Group257
+	out := make([]byte, n)
+	for i := range out {
+		e := (g.Log(uint32(b[i])+1) + 256 - g.Log(uint32(a[i])+1)) % 256
+		out[i] = byte(g.Exp(e) - 1)
+	}
+	return out
+}
+
+func chiSquare(data []byte) float64 {
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+	expected := float64(len(data)) / 256
+	x := 0.0
+	for _, c := range counts {
+		d := float64(c) - expected
+		x += d * d / expected
+	}
+	return x
+}
+
+// UnlinkReport is the advantage of every distinguisher on key pairs and state
+// pairs.
+type UnlinkReport struct {
+	Samples int      `json:"samples"`
+	Size    int      `json:"size"`
+	Hops    int      `json:"hops"`
+	Keys    []Result `json:"keys"`
+	States  []Result `json:"states"`
+}
+
+// Unlinkability runs every distinguisher on n key samples and n state samples
+// of size bytes, with routes of hops keys.
+func Unlinkability(pr cyclicKey.Params, n, size, hops int, rnd io.Reader) (*UnlinkReport, error) {
+	keys, err := KeySamples(pr, n, rnd)
+	if err != nil {
+		return nil, err
+	}
+	states, err := StateSamples(pr, n, size, hops, rnd)
+	if err != nil {
+		return nil, err
+	}
+	return &UnlinkReport{
+		Samples: n,
+		Size:    size,
+		Hops:    hops,
+		Keys:    Evaluate(pr, keys, KeyDistinguishers),
+		States:  Evaluate(pr, states, StateDistinguishers),
+	}, nil
+}
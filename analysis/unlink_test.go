@@ -0,0 +1,67 @@
+package analysis
+
+import (
+	mrand "math/rand"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestSamples(t *testing.T) {
+	pr := cyclicKey.DefaultParams()
+	rnd := mrand.New(mrand.NewSource(2))
+	states, err := StateSamples(pr, 20, 300, 4, rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range states {
+		if s.Linked != (i%2 == 0) || len(s.A) != 300 || len(s.B) != 300 {
+			t.Fatal("Bad state sample", i)
+		}
+	}
+	keys, err := KeySamples(pr, 20, rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range keys {
+		if s.Linked != (i%2 == 0) || len(s.A) != pr.KeyLength {
+			t.Fatal("Bad key sample", i)
+		}
+	}
+
+	if _, err := StateSamples(pr, 2, -1, 4, rnd); err != ErrStateSize {
+		t.Error("Expected ErrStateSize, got", err)
+	}
+	if _, err := StateSamples(pr, -1, 300, 4, rnd); err != ErrSamples {
+		t.Error("Expected ErrSamples, got", err)
+	}
+	if _, err := KeySamples(pr, -1, rnd); err != ErrSamples {
+		t.Error("Expected ErrSamples, got", err)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	samples := []Sample{{Linked: true}, {Linked: true}, {Linked: false}, {Linked: false}}
+	always := Distinguisher{Name: "always", Linked: func(cyclicKey.Params, []byte, []byte) bool { return true }}
+	r := Evaluate(cyclicKey.DefaultParams(), samples, []Distinguisher{always})
+	if r[0].TruePositive != 1 || r[0].FalsePositive != 1 || r[0].Advantage != 0 {
+		t.Errorf("Bad result: %+v", r[0])
+	}
+}
+
+func TestUnlinkability(t *testing.T) {
+	pr := cyclicKey.DefaultParams()
+	report, err := Unlinkability(pr, 40, 512, 3, mrand.New(mrand.NewSource(3)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range report.States {
+		// the linearization links every pair of states of the same message
+		if r.Name == "dlog_linearization" && r.Advantage != 1 {
+			t.Errorf("Expected the linearization to have advantage 1: %+v", r)
+		}
+	}
+	if len(report.Keys) != len(KeyDistinguishers) {
+		t.Error("Missing key results")
+	}
+}
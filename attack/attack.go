@@ -0,0 +1,197 @@
+// Package attack recovers cyclicKey keys from known plaintext. It is the
+// attack TestBreakIt demonstrates, carried through to the end for any key
+// length.
+//
+// With x[j] = k[j]+1 for each key byte, negated for an inverted key, the
+// discrete log of the key product at every position of a message is a linear
+// combination of the x[j] modulo 256, with coefficients that depend only on
+// the position and the parameters (see Params.Coefficients). The discrete log
+// of the key product is known from a plaintext byte and its ciphertext, so
+// each known byte is one linear equation in the key. The rotation multipliers
+// change the coefficients every time the root index wraps, which only helps:
+// a message that spans several rotations gives equations the key can't avoid.
+//
+// The root exponents are the odd numbers in order, so within one rotation the
+// coefficients of a key byte are affine in the position: each rotation gives
+// at most two independent equations, and a key of kl bytes needs known
+// plaintext from about kl/2 rotations, 64*kl bytes.
+//
+// Only odd numbers can be inverted modulo 256, so the equations are solved in
+// Howell form rather than by Gaussian elimination. When a byte of the key is
+// only ever multiplied by even coefficients it is only fixed modulo a smaller
+// power of two, and every key that fits is returned as a candidate. The last
+// key byte is never rotated, so if its multiplier is even some candidates are
+// left however much plaintext is known, but those are all equivalent. Keys
+// longer than 128 bytes leave more equivalent candidates: the parity of each
+// xorShift output is a linear function of its 128 bit state, so the top bits
+// of more than 128 key bytes can't all be told apart.
+//
+// The attack recovers an equivalent key: an inverted key and the key with the
+// exponents negated produce the same output, so the recovered key is never
+// inverted.
+package attack
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/AdamColton/cyclicKey"
+	"github.com/AdamColton/cyclicKey/internal/linmod"
+)
+
+// Errors returned by the solver
+var (
+	ErrGroup        = errors.New("attack: only Group257 is supported")
+	ErrLength       = errors.New("attack: plaintext and ciphertext lengths differ")
+	ErrInconsistent = errors.New("attack: pairs were not made by one key under these parameters")
+)
+
+// Pair is a known plaintext and its ciphertext under the key being attacked,
+// starting Offset bytes into the message.
+type Pair struct {
+	Plain, Cipher []byte
+	Offset        int64
+}
+
+// Solver accumulates known plaintext for one key of length kl. Pairs can be
+// added one at a time, and the number of candidate keys checked in between.
+type Solver struct {
+	pr  cyclicKey.Params
+	g   *cyclicKey.Group
+	kl  int
+	sys *linmod.System
+	n   int
+}
+
+// NewSolver returns a solver for a key of kl bytes under the parameter set.
+func NewSolver(pr cyclicKey.Params, kl int) (*Solver, error) {
+	g := pr.Group
+	if g == nil {
+		g = cyclicKey.Group257
+	}
+	if g != cyclicKey.Group257 {
+		return nil, ErrGroup
+	}
+	if kl < 1 {
+		return nil, cyclicKey.KeyLengthError(kl)
+	}
+	return &Solver{
+		pr:  pr,
+		g:   g,
+		kl:  kl,
+		sys: linmod.New(kl, uint(bits.TrailingZeros32(g.P()-1))),
+	}, nil
+}
+
+// Add adds the equations from one pair. It returns ErrInconsistent if the pair
+// contradicts the pairs already added, after which the solver can't be used.
+func (sv *Solver) Add(pair Pair) error {
+	if len(pair.Plain) != len(pair.Cipher) {
+		return ErrLength
+	}
+	s := sv.g.P() - 1
+	coeffs := sv.pr.Coefficients(sv.kl, pair.Offset, len(pair.Plain))
+	for i, row := range coeffs {
+		// log(kp) = log(c) - log(m), with both shifted into [1, p-1]
+		y := (sv.g.Log(uint32(pair.Cipher[i])+1) + s - sv.g.Log(uint32(pair.Plain[i])+1)) % s
+		if sv.sys.Add(row, y) != nil {
+			return ErrInconsistent
+		}
+	}
+	sv.n += len(pair.Plain)
+	return nil
+}
+
+// Bytes returns the number of known plaintext bytes added.
+func (sv *Solver) Bytes() int {
+	return sv.n
+}
+
+// CandidateBits returns the base 2 log of the number of keys that fit every
+// pair added so far, 0 once the key is unique. It returns -1 if the pairs were
+// inconsistent.
+func (sv *Solver) CandidateBits() int {
+	return sv.sys.SolutionBits()
+}
+
+// EquivalentBits returns the base 2 log of the number of candidates that can
+// never be told apart. The last key byte is never rotated, so when its
+// multiplier is a multiple of 2^v every one of its coefficients is too, and 2^v
+// keys produce the same output everywhere. Once CandidateBits reaches this the
+// key is as good as recovered. For keys longer than 128 bytes it is only a
+// lower bound.
+func (sv *Solver) EquivalentBits() int {
+	m := sv.pr.Rotations(sv.kl).Next()
+	return bits.TrailingZeros32(m[sv.kl-1])
+}
+
+// Candidates returns up to max keys that fit every pair added so far.
+func (sv *Solver) Candidates(max int) []cyclicKey.Key {
+	var keys []cyclicKey.Key
+	for _, x := range sv.sys.Solutions(max) {
+		keys = append(keys, sv.key(x))
+	}
+	return keys
+}
+
+// key converts exponents back to key bytes, k = x-1.
+func (sv *Solver) key(x []uint32) cyclicKey.Key {
+	s := sv.g.P() - 1
+	k := cyclicKey.Key{Data: make([]byte, len(x))}
+	for j, v := range x {
+		k.Data[j] = byte((v + s - 1) % s)
+	}
+	return k
+}
+
+// Result is a recovered key.
+type Result struct {
+	// Key is the first candidate, equivalent to the key that made the pairs
+	// when CandidateBits is EquivalentBits
+	Key cyclicKey.Key
+	// Exponents are the x[j] of Key
+	Exponents []uint32
+	// K32 are the exponents the cipher uses for each key byte at the start of
+	// the message, x[j] times the first rotation multiplier
+	K32 []uint32
+	// CandidateBits is the base 2 log of the number of keys that fit
+	CandidateBits int
+	// EquivalentBits is the base 2 log of the number of those that are
+	// equivalent to every other
+	EquivalentBits int
+}
+
+// Result returns the first candidate key along with its exponents.
+func (sv *Solver) Result() (*Result, error) {
+	if sv.sys.Err() != nil {
+		return nil, ErrInconsistent
+	}
+	x := sv.sys.Solutions(1)[0]
+	s := uint64(sv.g.P() - 1)
+	m := sv.pr.Rotations(sv.kl).Next()
+	k32 := make([]uint32, sv.kl)
+	for j := range k32 {
+		k32[j] = uint32(uint64(x[j]) * uint64(m[j]) % s)
+	}
+	return &Result{
+		Key:            sv.key(x),
+		Exponents:      x,
+		K32:            k32,
+		CandidateBits:  sv.CandidateBits(),
+		EquivalentBits: sv.EquivalentBits(),
+	}, nil
+}
+
+// Recover solves for a key of kl bytes from the pairs.
+func Recover(pr cyclicKey.Params, kl int, pairs ...Pair) (*Result, error) {
+	sv, err := NewSolver(pr, kl)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		if err := sv.Add(pair); err != nil {
+			return nil, err
+		}
+	}
+	return sv.Result()
+}
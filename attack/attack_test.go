@@ -0,0 +1,83 @@
+package attack
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestRecover(t *testing.T) {
+	pr := cyclicKey.DefaultParams()
+	for _, kl := range []int{2, 10, 40, 130} {
+		pr.KeyLength = kl
+		key := pr.RandomKeyset(3)[2]
+		// each rotation gives at most two independent equations
+		m := make([]byte, 128*(kl+10))
+		rand.Read(m)
+		c := pr.Cipher(m, key.Data, key.Invert)
+
+		// two pieces of the message, the second past several rotations
+		r, err := Recover(pr, kl,
+			Pair{Plain: m[:100], Cipher: c[:100]},
+			Pair{Plain: m[1000:], Cipher: c[1000:], Offset: 1000},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kl <= 128 && r.CandidateBits != r.EquivalentBits {
+			t.Error("Key length", kl, "left 2^", r.CandidateBits, "candidates")
+		}
+		other := make([]byte, 1000)
+		rand.Read(other)
+		expected := pr.Cipher(other, key.Data, key.Invert)
+		if !bytes.Equal(expected, pr.Cipher(other, r.Key.Data, false)) {
+			t.Error("Recovered key does not match with key length", kl)
+		}
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	// a short plaintext inside the first rotation can leave several keys,
+	// every one of which must produce the same ciphertext
+	pr := cyclicKey.DefaultParams()
+	key := cyclicKey.Key{Data: make([]byte, 10)}
+	rand.Read(key.Data)
+	m := make([]byte, 12)
+	rand.Read(m)
+	c := key.Apply(m)
+	sv, err := NewSolver(pr, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sv.Add(Pair{Plain: m, Cipher: c}); err != nil {
+		t.Fatal(err)
+	}
+	if sv.CandidateBits() == 0 {
+		t.Skip("unique from 12 bytes")
+	}
+	for _, k := range sv.Candidates(50) {
+		if !bytes.Equal(c, k.Apply(m)) {
+			t.Error("Candidate does not fit the pair")
+		}
+	}
+}
+
+func TestInconsistent(t *testing.T) {
+	keys := cyclicKey.RandomKeyset(3)
+	m := make([]byte, 1000)
+	rand.Read(m)
+	_, err := Recover(cyclicKey.DefaultParams(), 10,
+		Pair{Plain: m, Cipher: keys[0].Apply(m)},
+		Pair{Plain: m, Cipher: keys[1].Apply(m)},
+	)
+	if err != ErrInconsistent {
+		t.Error("Expected ErrInconsistent, got", err)
+	}
+
+	g, _ := cyclicKey.NewGroup(65537)
+	if _, err := NewSolver(cyclicKey.Params{Group: g}, 10); err != ErrGroup {
+		t.Error("Expected ErrGroup, got", err)
+	}
+}
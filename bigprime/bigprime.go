@@ -0,0 +1,187 @@
+// Package bigprime is a cyclic keyset backend built on commutative
+// exponentiation modulo a large safe prime, the Pohlig-Hellman / SRA cipher.
+// It keeps the semantics of cyclicKey: a keyset of n keys, applied in any
+// order, returns the original message. Where cyclicKey works modulo 257, so
+// that a discrete log table breaks it, the prime here is the 2048 bit MODP
+// group from RFC 3526, where no discrete log can be taken.
+//
+// A key is an exponent e with gcd(e, p-1) = 1 and applying it raises the
+// state to e. The exponents of a keyset multiply to 1 mod p-1, so applying
+// all of them raises the state to 1.
+//
+// p = 2q+1 with q prime. Messages are embedded as quadratic residues, the
+// subgroup of order q, so that the Legendre symbol, the one thing an odd
+// exponent preserves, is the same for every state.
+//
+// The package mirrors the keyset API of cyclicKey, NewKeyset, RandomKeyset and
+// Key.Apply, so code written against one works with the other. A message is
+// limited to MaxMessageSize bytes and every state in between is ElementLen
+// bytes.
+package bigprime
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+// ElementLen is the length in bytes of a message state, a group element
+// written big endian.
+const ElementLen = 256
+
+// MaxMessageSize is the longest message that can be embedded in a state,
+// leaving room for the marker, the length byte and padLen bytes of padding.
+const MaxMessageSize = ElementLen - markerLen - 1 - padLen
+
+// markerLen is the number of leading zero bytes that mark an embedded
+// message. A random state starts with them with probability about 2^-64.
+const markerLen = 8
+
+// padLen is the least random padding an embedded message gets, so that even a
+// message of MaxMessageSize bytes has 128 random bits in its state.
+const padLen = 16
+
+// modpHex is the 2048 bit MODP group prime from RFC 3526 section 3.
+const modpHex = "FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD1" +
+	"29024E088A67CC74020BBEA63B139B22514A08798E3404DD" +
+	"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245" +
+	"E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED" +
+	"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3D" +
+	"C2007CB8A163BF0598DA48361C55D39A69163FA8FD24CF5F" +
+	"83655D23DCA3AD961C62F356208552BB9ED529077096966D" +
+	"670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B" +
+	"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9" +
+	"DE2BCBF6955817183995497CEA956AE515D2261898FA0510" +
+	"15728E5A8AACAA68FFFFFFFFFFFFFFFF"
+
+var (
+	p, _ = new(big.Int).SetString(modpHex, 16)
+	// pm1 is p-1 = 2q, the modulus the exponents are taken in
+	pm1 = new(big.Int).Sub(p, big.NewInt(1))
+	q   = new(big.Int).Rsh(p, 1)
+	one = big.NewInt(1)
+)
+
+// Key is one key of a keyset, an exponent coprime to p-1.
+type Key struct {
+	Exp *big.Int
+}
+
+// Keyset is a cyclic set of keys.
+type Keyset []Key
+
+// Apply raises the state to the key's exponent. The input is either a message
+// of at most MaxMessageSize bytes, which is embedded first, or a state of
+// ElementLen bytes from an earlier Apply. When the result is an embedded
+// message, as it is once the last key of a keyset is applied, the message is
+// returned instead of the state. It panics if the input is neither.
+func (k Key) Apply(state []byte) []byte {
+	x := decode(state)
+	y := new(big.Int).Exp(x, k.Exp, p)
+	if msg, ok := extract(y); ok {
+		return msg
+	}
+	return y.FillBytes(make([]byte, ElementLen))
+}
+
+// decode returns the group element for a state or an embedded message.
+func decode(state []byte) *big.Int {
+	if len(state) == ElementLen {
+		return new(big.Int).SetBytes(state)
+	}
+	if len(state) > MaxMessageSize {
+		panic("bigprime: message longer than MaxMessageSize")
+	}
+	return embed(state)
+}
+
+// embed writes msg as markerLen zero bytes, a length byte, the message and at
+// least padLen bytes of random padding, so the value is less than p. The
+// padding keeps the value away from 1 and p-1, which every exponent fixes, and
+// means equal messages, of any length, don't give equal states. If the value
+// isn't a quadratic residue its negation is, because -1 is not a residue when
+// p is 3 mod 4.
+func embed(msg []byte) *big.Int {
+	b := make([]byte, ElementLen)
+	b[markerLen] = byte(len(msg))
+	copy(b[markerLen+1:], msg)
+	x := new(big.Int)
+	for {
+		if _, err := rand.Read(b[markerLen+1+len(msg):]); err != nil {
+			panic(err)
+		}
+		x.SetBytes(b)
+		if x.Cmp(one) > 0 {
+			break
+		}
+	}
+	if big.Jacobi(x, p) != 1 {
+		x.Sub(p, x)
+	}
+	return x
+}
+
+// extract returns the message embedded in y, if there is one.
+func extract(y *big.Int) ([]byte, bool) {
+	for _, x := range []*big.Int{y, new(big.Int).Sub(p, y)} {
+		b := x.FillBytes(make([]byte, ElementLen))
+		marked := int(b[markerLen]) <= MaxMessageSize
+		for _, v := range b[:markerLen] {
+			marked = marked && v == 0
+		}
+		if marked {
+			return b[markerLen+1 : markerLen+1+int(b[markerLen])], true
+		}
+	}
+	return nil, false
+}
+
+// NewKeyset generates a set of n keys. Randomness is read from rnd, or from
+// crypto/rand if rnd is nil.
+func NewKeyset(n int, rnd io.Reader) (Keyset, error) {
+	if n < 3 {
+		return nil, cyclicKey.KeyCountError(n)
+	}
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	keys := make(Keyset, n)
+	prod := big.NewInt(1)
+	for i := 0; i < n-1; i++ {
+		e, err := randomExp(rnd)
+		if err != nil {
+			return nil, err
+		}
+		keys[i].Exp = e
+		prod.Mul(prod, e).Mod(prod, pm1)
+	}
+	keys[n-1].Exp = new(big.Int).ModInverse(prod, pm1)
+	return keys, nil
+}
+
+// RandomKeyset generates a set of keys using crypto/rand. It panics where
+// NewKeyset would return an error.
+func RandomKeyset(n int) Keyset {
+	keys, err := NewKeyset(n, nil)
+	if err != nil {
+		panic(err)
+	}
+	return keys
+}
+
+// randomExp returns a random exponent in [3, p-1) coprime to p-1 = 2q: odd and
+// not a multiple of q.
+func randomExp(rnd io.Reader) (*big.Int, error) {
+	for {
+		e, err := rand.Int(rnd, pm1)
+		if err != nil {
+			return nil, &cyclicKey.EntropyError{Err: err}
+		}
+		e.SetBit(e, 0, 1)
+		if e.Cmp(one) > 0 && new(big.Int).Mod(e, q).Sign() != 0 {
+			return e, nil
+		}
+	}
+}
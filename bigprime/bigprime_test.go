@@ -0,0 +1,84 @@
+package bigprime
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestPrime(t *testing.T) {
+	if p.BitLen() != 2048 || !p.ProbablyPrime(20) || !q.ProbablyPrime(20) {
+		t.Fatal("p is not the 2048 bit safe prime")
+	}
+}
+
+func TestCycle(t *testing.T) {
+	for _, l := range []int{0, 1, 32, MaxMessageSize} {
+		m := make([]byte, l)
+		rand.Read(m)
+		for n := 3; n < 6; n++ {
+			keys := RandomKeyset(n)
+			c := m
+			for i, k := range mrand.Perm(n) {
+				c = keys[k].Apply(c)
+				if i < n-1 && len(c) != ElementLen {
+					t.Fatal("Intermediate state is not an element")
+				}
+			}
+			if !bytes.Equal(m, c) {
+				t.Error("Did not cycle with", n, "keys and length", l)
+			}
+		}
+	}
+}
+
+func TestStatesAreResidues(t *testing.T) {
+	keys := RandomKeyset(3)
+	c := keys[0].Apply([]byte("hello"))
+	if big.Jacobi(new(big.Int).SetBytes(c), p) != 1 {
+		t.Error("State is not a quadratic residue")
+	}
+}
+
+func TestEqualMessages(t *testing.T) {
+	// every length, up to the longest, gets random padding
+	k := RandomKeyset(3)[0]
+	for _, l := range []int{0, MaxMessageSize - 1, MaxMessageSize} {
+		m := make([]byte, l)
+		rand.Read(m)
+		if bytes.Equal(k.Apply(m), k.Apply(m)) {
+			t.Error("Equal messages of length", l, "gave equal states")
+		}
+	}
+}
+
+func TestNewKeyset(t *testing.T) {
+	if _, err := NewKeyset(2, nil); err != cyclicKey.KeyCountError(2) {
+		t.Error("Expected KeyCountError, got", err)
+	}
+	if _, err := NewKeyset(3, bytes.NewReader(make([]byte, 10))); err == nil {
+		t.Error("Expected an error from a short source")
+	}
+	seed := make([]byte, 4096)
+	rand.Read(seed)
+	a, _ := NewKeyset(3, bytes.NewReader(seed))
+	b, _ := NewKeyset(3, bytes.NewReader(seed))
+	for i := range a {
+		if a[i].Exp.Cmp(b[i].Exp) != 0 {
+			t.Error("Same source produced different keysets")
+		}
+	}
+}
+
+func TestOversized(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic")
+		}
+	}()
+	RandomKeyset(3)[0].Apply(make([]byte, MaxMessageSize+1))
+}
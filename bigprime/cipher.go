@@ -0,0 +1,95 @@
+package bigprime
+
+import (
+	"io"
+	"math/big"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+// Cipher is the bigprime backend as a cyclicKey.CyclicCipher, registered as
+// "bigprime". Its keys are Key values.
+var Cipher cyclicKey.CyclicCipher = backend{}
+
+func init() {
+	cyclicKey.Register(Cipher)
+}
+
+type backend struct{}
+
+func (backend) Name() string {
+	return "bigprime"
+}
+
+func (backend) GenerateKeyset(n int, rnd io.Reader) ([]cyclicKey.CipherKey, error) {
+	keys, err := NewKeyset(n, rnd)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]cyclicKey.CipherKey, len(keys))
+	for i, k := range keys {
+		out[i] = k
+	}
+	return out, nil
+}
+
+func (backend) Apply(key cyclicKey.CipherKey, msg []byte) ([]byte, error) {
+	k, ok := key.(Key)
+	if !ok {
+		return nil, cyclicKey.ErrKeyType
+	}
+	if !validExp(k.Exp) {
+		return nil, cyclicKey.ErrKeyEncoding
+	}
+	switch {
+	case len(msg) == ElementLen:
+		if !validState(msg) {
+			return nil, cyclicKey.ErrState
+		}
+	case len(msg) > MaxMessageSize:
+		return nil, cyclicKey.ErrMessageSize
+	}
+	return k.Apply(msg), nil
+}
+
+// validState reports whether a state is a quadratic residue in [2, p-2],
+// which every state Apply returns is.
+func validState(state []byte) bool {
+	x := new(big.Int).SetBytes(state)
+	return x.Cmp(one) > 0 && x.Cmp(pm1) < 0 && big.Jacobi(x, p) == 1
+}
+
+// validExp reports whether e is an exponent a key can have: odd, less than
+// p-1 and not a multiple of q.
+func validExp(e *big.Int) bool {
+	return e != nil && e.Bit(0) == 1 && e.Cmp(pm1) < 0 && new(big.Int).Mod(e, q).Sign() != 0
+}
+
+// EncodeKey writes the exponent big endian in ElementLen bytes. It rejects an
+// exponent DecodeKey would.
+func (backend) EncodeKey(key cyclicKey.CipherKey) ([]byte, error) {
+	k, ok := key.(Key)
+	if !ok {
+		return nil, cyclicKey.ErrKeyType
+	}
+	if !validExp(k.Exp) {
+		return nil, cyclicKey.ErrKeyEncoding
+	}
+	return k.Exp.FillBytes(make([]byte, ElementLen)), nil
+}
+
+// DecodeKey rejects an exponent that is not coprime to p-1.
+func (backend) DecodeKey(data []byte) (cyclicKey.CipherKey, error) {
+	if len(data) != ElementLen {
+		return nil, cyclicKey.ErrKeyEncoding
+	}
+	e := new(big.Int).SetBytes(data)
+	if !validExp(e) {
+		return nil, cyclicKey.ErrKeyEncoding
+	}
+	return Key{Exp: e}, nil
+}
+
+func (backend) MaxMessageSize() int {
+	return MaxMessageSize
+}
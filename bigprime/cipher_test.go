@@ -0,0 +1,100 @@
+package bigprime
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestCipherKeys(t *testing.T) {
+	c, err := cyclicKey.Lookup("bigprime")
+	if err != nil || c != Cipher {
+		t.Fatal("bigprime is not registered", err)
+	}
+	keys, err := c.GenerateKeyset(3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a message as long as the prime allows survives the named encoding of
+	// every key
+	msg := bytes.Repeat([]byte("pick by name "), 20)[:MaxMessageSize]
+	state := msg
+	for _, k := range keys {
+		b, err := cyclicKey.MarshalKey(c, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != 1+len("bigprime")+ElementLen {
+			t.Error("Encoded key is", len(b), "bytes")
+		}
+		_, dk, err := cyclicKey.UnmarshalKey(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dk.(Key).Exp.Cmp(k.(Key).Exp) != 0 {
+			t.Error("Exponent did not round trip")
+		}
+		if state, err = c.Apply(dk, state); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(msg, state) {
+		t.Error("Did not cycle")
+	}
+	if _, err := c.Apply(keys[0], append(msg, 0)); err != cyclicKey.ErrMessageSize {
+		t.Error("Expected ErrMessageSize, got", err)
+	}
+	if _, err := c.Apply(cyclicKey.Key{}, msg); err != cyclicKey.ErrKeyType {
+		t.Error("Expected ErrKeyType, got", err)
+	}
+}
+
+func TestCipherBadExponents(t *testing.T) {
+	// every exponent a key can't have: missing, even, a multiple of q and
+	// p-1 or more
+	bad := []*big.Int{
+		nil,
+		big.NewInt(2),
+		new(big.Int).Set(q),
+		new(big.Int).Set(pm1),
+		new(big.Int).Set(p),
+	}
+	for _, e := range bad {
+		if _, err := Cipher.Apply(Key{Exp: e}, []byte("x")); err != cyclicKey.ErrKeyEncoding {
+			t.Error("Apply with exponent", e, "expected ErrKeyEncoding, got", err)
+		}
+		if _, err := Cipher.EncodeKey(Key{Exp: e}); err != cyclicKey.ErrKeyEncoding {
+			t.Error("Encoding exponent", e, "expected ErrKeyEncoding, got", err)
+		}
+		if e == nil {
+			continue
+		}
+		if _, err := Cipher.DecodeKey(e.FillBytes(make([]byte, ElementLen))); err != cyclicKey.ErrKeyEncoding {
+			t.Error("Decoding exponent", e, "expected ErrKeyEncoding, got", err)
+		}
+	}
+}
+
+func TestCipherBadStates(t *testing.T) {
+	key := RandomKeyset(3)[0]
+	state, err := Cipher.Apply(key, []byte("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// states a peer could send that no Apply returns: 0, 1, x >= p and a
+	// non-residue, the negation of a state
+	nonResidue := new(big.Int).Sub(p, new(big.Int).SetBytes(state))
+	bad := [][]byte{
+		make([]byte, ElementLen),
+		big.NewInt(1).FillBytes(make([]byte, ElementLen)),
+		bytes.Repeat([]byte{0xff}, ElementLen),
+		nonResidue.FillBytes(make([]byte, ElementLen)),
+	}
+	for i, state := range bad {
+		if _, err := Cipher.Apply(key, state); err != cyclicKey.ErrState {
+			t.Error("Expected ErrState for bad state", i, "got", err)
+		}
+	}
+}
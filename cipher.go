@@ -0,0 +1,181 @@
+package cyclicKey
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Errors returned by the cipher registry and the implementations in it
+var (
+	ErrUnknownCipher = errors.New("cyclicKey: no cipher registered under that name")
+	ErrKeyType       = errors.New("cyclicKey: key belongs to a different cipher")
+	ErrMessageSize   = errors.New("cyclicKey: message is longer than the cipher allows")
+	ErrState         = errors.New("cyclicKey: state is not valid for the cipher")
+)
+
+// CipherKey is a key of one CyclicCipher implementation, for Cipher257 a Key.
+// Implementations return ErrKeyType when given a key of another.
+type CipherKey interface{}
+
+// CyclicCipher is an implementation of cyclic keysets: a set of keys that, all
+// applied to a message in any order, return it. The 257 cipher in this package
+// is one; packages with others register them when imported, in the way
+// database/sql drivers do:
+//
+//	import _ "github.com/AdamColton/cyclicKey/bigprime"
+//
+//	c, err := cyclicKey.Lookup("bigprime")
+type CyclicCipher interface {
+	// Name is the name the cipher is registered under
+	Name() string
+	// GenerateKeyset generates a set of n keys, reading randomness from rnd
+	// or from crypto/rand if rnd is nil
+	GenerateKeyset(n int, rnd io.Reader) ([]CipherKey, error)
+	// Apply applies key to msg, which is a message or the output of an
+	// earlier Apply. It returns ErrMessageSize for a message that is too
+	// long and ErrState for input that no Apply could have produced. It
+	// must not panic, whatever a peer sends.
+	Apply(key CipherKey, msg []byte) ([]byte, error)
+	EncodeKey(key CipherKey) ([]byte, error)
+	DecodeKey(data []byte) (CipherKey, error)
+	// MaxMessageSize is the longest message that can be applied, 0 for no
+	// limit
+	MaxMessageSize() int
+}
+
+var (
+	ciphersMu sync.RWMutex
+	ciphers   = make(map[string]CyclicCipher)
+)
+
+// Register makes a cipher available by name. It panics if the cipher is nil or
+// the name is already taken, as it is meant to be called from init.
+func Register(c CyclicCipher) {
+	ciphersMu.Lock()
+	defer ciphersMu.Unlock()
+	if c == nil {
+		panic("cyclicKey: Register cipher is nil")
+	}
+	name := c.Name()
+	if len(name) == 0 || len(name) > 255 {
+		panic("cyclicKey: Register cipher name must be 1 to 255 bytes")
+	}
+	if _, dup := ciphers[name]; dup {
+		panic("cyclicKey: Register called twice for cipher " + name)
+	}
+	ciphers[name] = c
+}
+
+// Lookup returns the cipher registered under name.
+func Lookup(name string) (CyclicCipher, error) {
+	ciphersMu.RLock()
+	defer ciphersMu.RUnlock()
+	c, ok := ciphers[name]
+	if !ok {
+		return nil, ErrUnknownCipher
+	}
+	return c, nil
+}
+
+// Ciphers returns the names of the registered ciphers, sorted.
+func Ciphers() []string {
+	ciphersMu.RLock()
+	defer ciphersMu.RUnlock()
+	names := make([]string, 0, len(ciphers))
+	for name := range ciphers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MarshalKey encodes a key of c prefixed with the name of c, a length byte
+// followed by the name, so that UnmarshalKey can find the cipher again.
+func MarshalKey(c CyclicCipher, key CipherKey) ([]byte, error) {
+	kb, err := c.EncodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	name := c.Name()
+	b := make([]byte, 0, 1+len(name)+len(kb))
+	b = append(b, byte(len(name)))
+	b = append(b, name...)
+	return append(b, kb...), nil
+}
+
+// UnmarshalKey decodes a key written by MarshalKey, returning the cipher it
+// belongs to. The cipher must be registered.
+func UnmarshalKey(data []byte) (CyclicCipher, CipherKey, error) {
+	if len(data) < 1 || len(data) < 1+int(data[0]) {
+		return nil, nil, ErrKeyEncoding
+	}
+	c, err := Lookup(string(data[1 : 1+data[0]]))
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := c.DecodeKey(data[1+data[0]:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, key, nil
+}
+
+// Cipher257 is the cipher of this package under the default parameters,
+// registered as "cyclic257". Its keys are Key values, and keysets have the
+// length of the package variable KeyLength when they are generated.
+var Cipher257 CyclicCipher = cipher257{}
+
+func init() {
+	Register(Cipher257)
+}
+
+type cipher257 struct{}
+
+func (cipher257) Name() string {
+	return "cyclic257"
+}
+
+func (cipher257) GenerateKeyset(n int, rnd io.Reader) ([]CipherKey, error) {
+	keys, err := NewKeyset(n, rnd)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]CipherKey, len(keys))
+	for i, k := range keys {
+		out[i] = k
+	}
+	return out, nil
+}
+
+func (cipher257) Apply(key CipherKey, msg []byte) ([]byte, error) {
+	k, ok := key.(Key)
+	if !ok {
+		return nil, ErrKeyType
+	}
+	if len(k.Data) == 0 {
+		return nil, ErrKeyEncoding
+	}
+	return k.Apply(msg), nil
+}
+
+func (cipher257) EncodeKey(key CipherKey) ([]byte, error) {
+	k, ok := key.(Key)
+	if !ok {
+		return nil, ErrKeyType
+	}
+	return k.MarshalBinary()
+}
+
+func (cipher257) DecodeKey(data []byte) (CipherKey, error) {
+	var k Key
+	if err := k.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (cipher257) MaxMessageSize() int {
+	return 0
+}
@@ -0,0 +1,88 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+// cycleThrough applies every key of a keyset generated by c, checking that
+// the message comes back.
+func cycleThrough(t *testing.T, c CyclicCipher, msg []byte) {
+	keys, err := c.GenerateKeyset(4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := msg
+	for _, k := range keys {
+		// every key survives encoding with the cipher name
+		b, err := MarshalKey(c, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dc, dk, err := UnmarshalKey(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dc.Name() != c.Name() {
+			t.Fatal("Decoded key belongs to", dc.Name())
+		}
+		state, err = dc.Apply(dk, state)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(msg, state) {
+		t.Error("Did not cycle with", c.Name())
+	}
+}
+
+func TestCipher257(t *testing.T) {
+	c, err := Lookup("cyclic257")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != Cipher257 || c.MaxMessageSize() != 0 {
+		t.Error("Wrong cipher registered")
+	}
+	m := make([]byte, 1000)
+	rand.Read(m)
+	cycleThrough(t, c, m)
+
+	if _, err := c.Apply("not a key", m); err != ErrKeyType {
+		t.Error("Expected ErrKeyType, got", err)
+	}
+	if _, err := c.Apply(Key{}, m); err != ErrKeyEncoding {
+		t.Error("Expected ErrKeyEncoding for an empty key, got", err)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	if _, err := Lookup("no such cipher"); err != ErrUnknownCipher {
+		t.Error("Expected ErrUnknownCipher, got", err)
+	}
+	found := false
+	for _, name := range Ciphers() {
+		found = found || name == "cyclic257"
+	}
+	if !found {
+		t.Error("cyclic257 is not listed")
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected a panic registering a name twice")
+			}
+		}()
+		Register(Cipher257)
+	}()
+
+	b, _ := MarshalKey(Cipher257, RandomKeyset(3)[0])
+	b[1] = 'x'
+	if _, _, err := UnmarshalKey(b); err != ErrUnknownCipher {
+		t.Error("Expected ErrUnknownCipher, got", err)
+	}
+	if _, _, err := UnmarshalKey([]byte{5, 'a'}); err != ErrKeyEncoding {
+		t.Error("Expected ErrKeyEncoding, got", err)
+	}
+}
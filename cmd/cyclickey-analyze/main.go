@@ -0,0 +1,71 @@
+// Command cyclickey-analyze runs the analysis package's statistical tests on
+// the output of Cipher and writes the report as JSON.
+//
+//	cyclickey-analyze -kl 10 -size 1000000 > report.json
+//
+// With -samples the unlinkability distinguishers are run as well, on that many
+// pairs of keys and pairs of message states:
+//
+//	cyclickey-analyze -samples 1000 -state 1024 -hops 4
+//
+// With -seed the key is drawn from a deterministic source, so runs against
+// different versions of the cipher can be compared directly.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"os"
+
+	"github.com/AdamColton/cyclicKey"
+	"github.com/AdamColton/cyclicKey/analysis"
+)
+
+func main() {
+	kl := flag.Int("kl", cyclicKey.KeyLength, "key length")
+	size := flag.Int("size", 1<<20, "bytes of each stream to analyze")
+	seed := flag.Int64("seed", 0, "seed for a deterministic key, 0 for a random key")
+	plainFile := flag.String("plain", "", "plaintext file to repeat, default a line of English text")
+	samples := flag.Int("samples", 0, "number of samples for the unlinkability distinguishers, 0 to skip them")
+	state := flag.Int("state", 1024, "size of the message states the distinguishers see")
+	hops := flag.Int("hops", 4, "number of keys on a route")
+	flag.Parse()
+
+	pr := cyclicKey.DefaultParams()
+	pr.KeyLength = *kl
+	cfg := analysis.Config{
+		Params:    pr,
+		Size:      *size,
+		Samples:   *samples,
+		StateSize: *state,
+		Hops:      *hops,
+	}
+	if *seed != 0 {
+		cfg.Rand = rand.New(rand.NewSource(*seed))
+	}
+	if *plainFile != "" {
+		b, err := ioutil.ReadFile(*plainFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cfg.Plaintext = b
+	}
+
+	if err := run(cfg, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(cfg analysis.Config, w io.Writer) error {
+	report, err := analysis.Run(cfg)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+	"github.com/AdamColton/cyclicKey/analysis"
+)
+
+func TestRun(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := analysis.Config{
+		Params:    cyclicKey.DefaultParams(),
+		Size:      5000,
+		Samples:   10,
+		StateSize: 256,
+		Hops:      3,
+	}
+	err := run(cfg, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report analysis.Report
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Size != 5000 || report.Ciphertext.Bytes != 5000 || report.Unlinkability == nil {
+		t.Errorf("Bad report: %+v", report)
+	}
+}
+
+func TestRunBadKeyLength(t *testing.T) {
+	pr := cyclicKey.DefaultParams()
+	pr.KeyLength = 0
+	var buf bytes.Buffer
+	if err := run(analysis.Config{Params: pr, Size: 10}, &buf); err == nil {
+		t.Error("Expected an error for key length 0")
+	}
+}
+
+func TestRunBadFlags(t *testing.T) {
+	good := analysis.Config{
+		Params:    cyclicKey.DefaultParams(),
+		Size:      10,
+		StateSize: 16,
+		Hops:      2,
+	}
+	bad := []func(*analysis.Config){
+		func(c *analysis.Config) { c.Size = -1 },
+		func(c *analysis.Config) { c.StateSize = -1 },
+		func(c *analysis.Config) { c.Samples = -1 },
+		func(c *analysis.Config) { c.Samples, c.Hops = 1, 0 },
+	}
+	for i, f := range bad {
+		cfg := good
+		f(&cfg)
+		var buf bytes.Buffer
+		if err := run(cfg, &buf); err == nil {
+			t.Error("Expected an error for bad config", i)
+		}
+	}
+}
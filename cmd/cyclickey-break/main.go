@@ -0,0 +1,116 @@
+// Command cyclickey-break recovers a cyclicKey key from a known plaintext and
+// its ciphertext, using the attack package.
+//
+//	cyclickey-break -plain msg.txt -cipher msg.enc -kl 10
+//
+// The plaintext is fed to the solver step bytes at a time. After each step a
+// line reports how many bytes have been used and how many candidate keys are
+// left. It stops once every candidate left is equivalent or the input runs
+// out, and prints the recovered key to standard output as a PEM block, as
+// Key.MarshalText writes it. The report goes to standard error.
+//
+// If the capture doesn't start at the beginning of the message, -offset gives
+// its position in the message.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/AdamColton/cyclicKey"
+	"github.com/AdamColton/cyclicKey/attack"
+)
+
+func main() {
+	plainFile := flag.String("plain", "", "known plaintext file")
+	cipherFile := flag.String("cipher", "", "ciphertext file")
+	kl := flag.Int("kl", cyclicKey.KeyLength, "key length")
+	offset := flag.Int64("offset", 0, "position of the capture in the message")
+	step := flag.Int("step", 128, "bytes added between reports")
+	flag.Parse()
+
+	if *plainFile == "" || *cipherFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	plain, err := ioutil.ReadFile(*plainFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cipher, err := ioutil.ReadFile(*cipherFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	key, err := run(plain, cipher, *kl, *offset, *step, os.Stderr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	text, err := key.MarshalText()
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Stdout.Write(text)
+}
+
+// run feeds the pair to the solver step bytes at a time, writing a line to
+// report after each step, and returns the first candidate key.
+func run(plain, cipher []byte, kl int, offset int64, step int, report io.Writer) (cyclicKey.Key, error) {
+	if len(plain) != len(cipher) {
+		return cyclicKey.Key{}, errors.New("cyclickey-break: plaintext and ciphertext lengths differ")
+	}
+	if step < 1 {
+		step = 1
+	}
+	sv, err := attack.NewSolver(cyclicKey.DefaultParams(), kl)
+	if err != nil {
+		return cyclicKey.Key{}, err
+	}
+
+	fmt.Fprintf(report, "key length %d, %d bytes of known plaintext\n", kl, len(plain))
+	for i := 0; i < len(plain); i += step {
+		end := i + step
+		if end > len(plain) {
+			end = len(plain)
+		}
+		err := sv.Add(attack.Pair{
+			Plain:  plain[i:end],
+			Cipher: cipher[i:end],
+			Offset: offset + int64(i),
+		})
+		if err != nil {
+			return cyclicKey.Key{}, err
+		}
+		fmt.Fprintf(report, "%d bytes\t%s candidates\n", sv.Bytes(), count(sv.CandidateBits()))
+		if sv.CandidateBits() == sv.EquivalentBits() {
+			break
+		}
+	}
+
+	r, err := sv.Result()
+	if err != nil {
+		return cyclicKey.Key{}, err
+	}
+	switch {
+	case r.CandidateBits == 0:
+		fmt.Fprintf(report, "key recovered from %d bytes\n", sv.Bytes())
+	case r.CandidateBits == r.EquivalentBits:
+		fmt.Fprintf(report, "key recovered from %d bytes, one of %s equivalent keys\n", sv.Bytes(), count(r.CandidateBits))
+	default:
+		fmt.Fprintf(report, "%s candidates left, the key printed is the first of them\n", count(r.CandidateBits))
+	}
+	return r.Key, nil
+}
+
+// count formats 2^bits, exactly while it is small.
+func count(bits int) string {
+	if bits < 20 {
+		return fmt.Sprint(1 << uint(bits))
+	}
+	return fmt.Sprintf("2^%d", bits)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestRun(t *testing.T) {
+	key := cyclicKey.RandomKeyset(3)[1]
+	m := make([]byte, 2000)
+	rand.Read(m)
+	c := key.Apply(m)
+
+	var report bytes.Buffer
+	got, err := run(m[500:], c[500:], len(key.Data), 500, 128, &report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := make([]byte, 1000)
+	rand.Read(other)
+	if !bytes.Equal(key.Apply(other), got.Apply(other)) {
+		t.Error("Recovered key does not match")
+	}
+	if !strings.Contains(report.String(), "bytes\t") {
+		t.Error("Report has no steps:", report.String())
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	if _, err := run(make([]byte, 10), make([]byte, 11), 10, 0, 128, ioutil.Discard); err == nil {
+		t.Error("Expected an error for mismatched lengths")
+	}
+	if _, err := run(make([]byte, 10), make([]byte, 10), 0, 0, 128, ioutil.Discard); err == nil {
+		t.Error("Expected an error for a bad key length")
+	}
+}
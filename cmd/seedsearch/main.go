@@ -0,0 +1,320 @@
+// Command seedsearch measures how much the key rotation vectors of the cipher
+// overlap for a set of xorShift seeds, and searches for seeds that overlap
+// less.
+//
+// Two rotation vectors overlap in t positions when they use the same
+// multiplier for t of the key symbols. The search reports the first rotation
+// that overlaps an earlier one in at least t positions, and from that the safe
+// data volume: the number of bytes that can be ciphered before it is used.
+// The last key symbol is never rotated, so it counts towards every overlap: the
+// default -t 5 finds the first pair that shares 5 multipliers, 4 of them
+// rotated, and -t 6 the first that shares 5 rotated ones.
+//
+// Every rotation is indexed under each of its C(kl, t) subsets of t positions,
+// keyed on the positions and the multipliers in them, so a repeat is found
+// with one lookup per subset instead of comparing against every earlier
+// rotation.
+//
+//	seedsearch -kl 10 -t 5                      measure the package seeds
+//	seedsearch -seeds 1,2,3,4                   measure other seeds
+//	seedsearch -candidates 64 -workers 8        search random seeds
+//	seedsearch -source chacha20 -candidates 8   compare another rotation source
+//
+// The seed set is used directly by the xorShift source. The other sources
+// derive their seed from it: splitmix64 is seeded with the first two seeds and
+// chacha20 is keyed with all four.
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/bits"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func main() {
+	kl := flag.Int("kl", 10, "key length")
+	t := flag.Int("t", 5, "number of shared positions that counts as an overlap")
+	max := flag.Int("n", 300000, "maximum number of rotations to check")
+	seeds := flag.String("seeds", "", "comma separated seeds to measure, default the package seeds")
+	candidates := flag.Int("candidates", 0, "number of random seed sets to search")
+	workers := flag.Int("workers", runtime.NumCPU(), "number of candidates measured in parallel")
+	source := flag.String("source", "xorshift", "rotation source: xorshift, splitmix64 or chacha20")
+	flag.Parse()
+
+	src, ok := sources[*source]
+	if !ok {
+		log.Fatal("seedsearch: unknown rotation source ", *source)
+	}
+
+	var sets [][4]uint32
+	if *seeds != "" {
+		s, err := parseSeeds(*seeds)
+		if err != nil {
+			log.Fatal(err)
+		}
+		sets = append(sets, s)
+	}
+	for i := 0; i < *candidates; i++ {
+		sets = append(sets, randomSeeds())
+	}
+	if len(sets) == 0 {
+		sets = append(sets, cyclicKey.DefaultParams().Seeds)
+	}
+
+	results, err := search(sets, src, *kl, *t, *max, *workers)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Bytes > results[j].Bytes
+	})
+	for _, r := range results {
+		fmt.Fprintln(os.Stdout, r)
+	}
+}
+
+// Result is the overlap measurement for one seed set.
+type Result struct {
+	Seeds [4]uint32
+	// Rotation is the first rotation that overlaps an earlier one, or -1 if
+	// none did within the rotations checked.
+	Rotation int64
+	// Overlaps is the earlier rotation it overlaps
+	Overlaps int64
+	// Bytes is the safe data volume, the offset the overlapping rotation is
+	// used from, or the offset after the last rotation checked.
+	Bytes int64
+}
+
+func (r Result) String() string {
+	seeds := fmt.Sprintf("%d,%d,%d,%d", r.Seeds[0], r.Seeds[1], r.Seeds[2], r.Seeds[3])
+	if r.Rotation < 0 {
+		return fmt.Sprintf("%s\tno overlap\tsafe for at least %d bytes", seeds, r.Bytes)
+	}
+	return fmt.Sprintf("%s\trotation %d overlaps %d\tsafe for %d bytes", seeds, r.Rotation, r.Overlaps, r.Bytes)
+}
+
+// sourceFunc builds the rotation source for a seed set, nil means the default
+// xorShift source.
+type sourceFunc func(seeds [4]uint32) func() cyclicKey.RotationSource
+
+var sources = map[string]sourceFunc{
+	"xorshift": nil,
+	"splitmix64": func(seeds [4]uint32) func() cyclicKey.RotationSource {
+		seed := uint64(seeds[0])<<32 | uint64(seeds[1])
+		return func() cyclicKey.RotationSource {
+			return cyclicKey.NewSplitMix64(seed)
+		}
+	},
+	"chacha20": func(seeds [4]uint32) func() cyclicKey.RotationSource {
+		var key [32]byte
+		for i, v := range seeds {
+			binary.LittleEndian.PutUint32(key[4*i:], v)
+		}
+		return func() cyclicKey.RotationSource {
+			return cyclicKey.NewChaCha20(key, [12]byte{})
+		}
+	},
+}
+
+// search measures every seed set, workers at a time.
+func search(sets [][4]uint32, src sourceFunc, kl, t, max, workers int) ([]Result, error) {
+	if kl < 1 || t < 1 || t > kl || max < 1 || max > math.MaxInt32 {
+		return nil, errors.New("seedsearch: need 1 <= t <= kl and 1 <= n < 2^31")
+	}
+	if _, err := newIndex(kl, t); err != nil {
+		return nil, err
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]Result, len(sets))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				results[i] = measure(sets[i], src, kl, t, max)
+			}
+		}()
+	}
+	for i := range sets {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	return results, nil
+}
+
+// measure finds the first of max rotations that overlaps an earlier one in at
+// least t positions.
+func measure(seeds [4]uint32, src sourceFunc, kl, t, max int) Result {
+	pr := cyclicKey.DefaultParams()
+	pr.Seeds = seeds
+	if src != nil {
+		pr.Rotation = src(seeds)
+	}
+	rs := pr.Rotations(kl)
+	idx, _ := newIndex(kl, t)
+	for n := int64(0); n < int64(max); n++ {
+		if prev, ok := idx.add(rs.Next(), n); ok {
+			return Result{Seeds: seeds, Rotation: n, Overlaps: prev, Bytes: rs.Offset()}
+		}
+	}
+	rs.Next()
+	return Result{Seeds: seeds, Rotation: -1, Overlaps: -1, Bytes: rs.Offset()}
+}
+
+// index records every subset of t positions of each rotation added to it. A
+// key packs the subset number and the multipliers in the subset, each less
+// one, into a uint64 with the low bit set so that 0 marks an empty slot. The
+// multipliers are at most 256, as they are for Group257.
+//
+// The table is open addressed and doubles when it is half full. Most seed sets
+// overlap early, so it only grows large for the good ones.
+type index struct {
+	subsets [][]int
+	keys    []uint64
+	rots    []int32
+	shift   uint
+	used    int
+}
+
+const vbits = 8
+
+func newIndex(kl, t int) (*index, error) {
+	subsets := combinations(kl, t)
+	if 1+bits.Len(uint(len(subsets)))+vbits*t > 64 {
+		return nil, errors.New("seedsearch: t is too large to index")
+	}
+	idx := &index{subsets: subsets}
+	idx.resize(1 << 10)
+	return idx, nil
+}
+
+// add indexes rotation n with multipliers m. If an earlier rotation shares a
+// subset it returns that rotation and true, without indexing n.
+func (idx *index) add(m []uint32, n int64) (int64, bool) {
+	keys := make([]uint64, len(idx.subsets))
+	for i, sub := range idx.subsets {
+		k := uint64(i)
+		for _, j := range sub {
+			k = k<<vbits | uint64(m[j]-1)
+		}
+		keys[i] = k<<1 | 1
+		if prev, ok := idx.lookup(keys[i]); ok {
+			return int64(prev), true
+		}
+	}
+	if 2*(idx.used+len(keys)) > len(idx.keys) {
+		idx.resize(2 * len(idx.keys))
+	}
+	for _, k := range keys {
+		idx.insert(k, int32(n))
+	}
+	return 0, false
+}
+
+func (idx *index) slot(k uint64) int {
+	// a multiplicative hash, the top bits are the best mixed
+	return int((k * 0x9e3779b97f4a7c15) >> idx.shift)
+}
+
+func (idx *index) lookup(k uint64) (int32, bool) {
+	mask := len(idx.keys) - 1
+	for i := idx.slot(k); idx.keys[i] != 0; i = (i + 1) & mask {
+		if idx.keys[i] == k {
+			return idx.rots[i], true
+		}
+	}
+	return 0, false
+}
+
+func (idx *index) insert(k uint64, n int32) {
+	mask := len(idx.keys) - 1
+	i := idx.slot(k)
+	for idx.keys[i] != 0 {
+		i = (i + 1) & mask
+	}
+	idx.keys[i], idx.rots[i] = k, n
+	idx.used++
+}
+
+func (idx *index) resize(size int) {
+	keys, rots := idx.keys, idx.rots
+	idx.keys, idx.rots = make([]uint64, size), make([]int32, size)
+	idx.shift = uint(64 - bits.TrailingZeros(uint(size)))
+	idx.used = 0
+	for i, k := range keys {
+		if k != 0 {
+			idx.insert(k, rots[i])
+		}
+	}
+}
+
+// combinations returns every subset of t of the positions [0, n), ascending.
+func combinations(n, t int) [][]int {
+	var out [][]int
+	sub := make([]int, t)
+	var rec func(start, depth int)
+	rec = func(start, depth int) {
+		if depth == t {
+			out = append(out, append([]int(nil), sub...))
+			return
+		}
+		for i := start; i <= n-(t-depth); i++ {
+			sub[depth] = i
+			rec(i+1, depth+1)
+		}
+	}
+	rec(0, 0)
+	return out
+}
+
+func parseSeeds(str string) ([4]uint32, error) {
+	var seeds [4]uint32
+	parts := strings.Split(str, ",")
+	if len(parts) != 4 {
+		return seeds, errors.New("seedsearch: need 4 seeds")
+	}
+	for i, part := range parts {
+		v, err := strconv.ParseUint(strings.TrimSpace(part), 10, 32)
+		if err != nil {
+			return seeds, err
+		}
+		seeds[i] = uint32(v)
+	}
+	return seeds, nil
+}
+
+func randomSeeds() [4]uint32 {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		log.Fatal(err)
+	}
+	var seeds [4]uint32
+	for i := range seeds {
+		seeds[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+	// xorShift needs a non zero state
+	if seeds == [4]uint32{} {
+		seeds[0] = 1
+	}
+	return seeds
+}
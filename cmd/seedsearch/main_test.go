@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+// bruteForce compares every rotation against every earlier one. It returns the
+// first rotation that overlaps and every earlier rotation it overlaps.
+func bruteForce(seeds [4]uint32, src sourceFunc, kl, t, max int) (int64, map[int64]bool) {
+	pr := cyclicKey.DefaultParams()
+	pr.Seeds = seeds
+	if src != nil {
+		pr.Rotation = src(seeds)
+	}
+	rs := pr.Rotations(kl)
+	var past [][]uint32
+	for n := 0; n < max; n++ {
+		m := append([]uint32(nil), rs.Next()...)
+		overlaps := make(map[int64]bool)
+		for i, q := range past {
+			c := 0
+			for j := range m {
+				if m[j] == q[j] {
+					c++
+				}
+			}
+			if c >= t {
+				overlaps[int64(i)] = true
+			}
+		}
+		if len(overlaps) > 0 {
+			return int64(n), overlaps
+		}
+		past = append(past, m)
+	}
+	return -1, nil
+}
+
+func TestMeasureMatchesBruteForce(t *testing.T) {
+	for name, src := range sources {
+		for _, c := range []struct{ kl, t int }{{4, 2}, {6, 3}, {10, 4}, {10, 5}} {
+			seeds := randomSeeds()
+			r := measure(seeds, src, c.kl, c.t, 3000)
+			n, overlaps := bruteForce(seeds, src, c.kl, c.t, 3000)
+			if r.Rotation != n || (n >= 0 && !overlaps[r.Overlaps]) {
+				t.Error(name, "kl", c.kl, "t", c.t, "got", r.Rotation, r.Overlaps, "expected", n, overlaps)
+			}
+		}
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	if n := len(combinations(10, 4)); n != 210 {
+		t.Error("Expected 210 subsets, got", n)
+	}
+	if n := len(combinations(5, 5)); n != 1 {
+		t.Error("Expected 1 subset, got", n)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	sets := [][4]uint32{randomSeeds(), randomSeeds(), randomSeeds()}
+	results, err := search(sets, nil, 10, 4, 2000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if r.Seeds != sets[i] || r.Bytes <= 0 {
+			t.Error("Bad result", r)
+		}
+	}
+	if _, err := search(sets, nil, 4, 5, 10, 1); err == nil {
+		t.Error("Expected an error for t > kl")
+	}
+}
@@ -9,17 +9,16 @@ order) the original plain text is recovered.
 
 package cyclicKey
 
-import (
-	"crypto/rand"
-)
-
 const p = uint32(257)
 const lpr = uint32(3)
 const s = p - 1
 
-// XorShift seeds. These guarentee no more than 4 overlapping rotation values
-// in the first 278K rotations for a key length of 10. That's enough for about
-// 35MB of data, beyond that, multiple key-sets should be used.
+// XorShift seeds. For a key length of 10, no two of the first 9784 rotations
+// share more than 4 rotation values, about 1.2MB of data. The last key symbol
+// is never rotated, so it is one of the shared values in every pair. Counting
+// only the 9 rotated values, no two of the first 144K rotations share more
+// than 4, about 18MB. Beyond that, multiple key-sets should be used.
+// cmd/seedsearch measures both, with -t 5 and -t 6.
 var seed1 = uint32(2339296992)
 var seed2 = uint32(2884812447)
 var seed3 = uint32(2692626613)
@@ -38,6 +37,14 @@ func xorShift(xs1, xs2, xs3, xs4 uint32) (uint32, uint32, uint32, uint32) {
 // It cannot be called either an encryption or decryption function because often
 // the caller does not know what sort of action they are requesting, and often
 // one cipher text is being converted to another cipher text.
+func Cipher(input, key []byte, invert bool) []byte {
+	return defaultParams.Cipher(input, key, invert)
+}
+
+// state is everything the cipher carries from one byte of the message to the
+// next. Cipher uses a fresh state for each call, the streaming wrappers keep
+// one across calls so that chunked input produces the same output as a single
+// call.
 //
 // k32 : A key is stored and transmitted as a byte slice, but for use it needs
 //       to be converted to uint32 and incremented by 1
@@ -45,7 +52,8 @@ func xorShift(xs1, xs2, xs3, xs4 uint32) (uint32, uint32, uint32, uint32) {
 // cl  : cipher length; length of both input and output
 // kl  : length of key in bytes
 // xs1-4 : xorShift values to produce the rotation values, xs4 is used as the
-//         random value
+//         random value. They are only used when the parameters don't give a
+//         RotationSource, in rot.
 //
 // Primative roots form a queue. The queue is one longer than necessary so that
 // in the inner loop we can progress the queue without overflowing.
@@ -56,33 +64,207 @@ func xorShift(xs1, xs2, xs3, xs4 uint32) (uint32, uint32, uint32, uint32) {
 // algorithm is working with numbers upto 257 in uint32 space, so it's not
 // necessary to perform mod each time. doMod accumulates how many
 // multiplications we've done and when it reaches 3 we need to do the mod op.
-func Cipher(input, key []byte, invert bool) []byte {
-	//setup
-	xs1, xs2, xs3, xs4 := seed1, seed2, seed3, seed4
-	kl := len(key)
-	k32 := make([]uint32, kl)
-	root := make([]uint32, kl+1)
-	ri := uint32(0)
+// The product is held in a uint64: a reduced value can be 256 and 256^4 does
+// not fit in a uint32.
+type state struct {
+	g                  *Group
+	seeds              [4]uint32
+	source             func() RotationSource
+	rot                RotationSource
+	key                []byte
+	invert             bool
+	xs1, xs2, xs3, xs4 uint32
+	k32                []uint32
+	root               []uint32
+	ri                 uint32
+	// kps is scratch space for the key products of one chunk of the message.
+	// For a group without power mod tables they hold the exponent of the key
+	// product instead.
+	kps [128]uint32
+	// base is where the nonce starts the message in the schedule, and mask is
+	// the xorShift stream it mixes into the rotation values when masked is set
+	base      int64
+	maskSeeds [4]uint32
+	mask      XorShift
+	masked    bool
+}
+
+func newState(pr *Params, key []byte, invert bool) *state {
+	st := &state{}
+	st.reset(pr, key, invert)
+	return st
+}
+
+// reset puts the state back at the start of a message for the given
+// parameters and key.
+func (st *state) reset(pr *Params, key []byte, invert bool) {
+	st.g, st.seeds, st.source = pr.group(), pr.seeds(), pr.Rotation
+	st.base, st.maskSeeds, st.masked = pr.nonceShift()
+	st.restart(key, invert)
+}
+
+// restart puts the state back at the start of a message for the given key,
+// keeping the parameters.
+func (st *state) restart(key []byte, invert bool) {
+	st.start(key, invert)
+	if st.base != 0 {
+		st.jump(st.base)
+	}
+}
+
+// start puts the state at the very start of the schedule for the given key,
+// before any nonce has moved it.
+func (st *state) start(key []byte, invert bool) {
+	g := st.g
+	st.xs1, st.xs2, st.xs3, st.xs4 = st.seeds[0], st.seeds[1], st.seeds[2], st.seeds[3]
+	st.rot = nil
+	if st.source != nil {
+		st.rot = st.source()
+	}
+	st.mask = XorShift{st.maskSeeds[0], st.maskSeeds[1], st.maskSeeds[2], st.maskSeeds[3]}
+	kl := g.keyLen(key)
+	// reuse the buffers from a previous message when they are large enough
+	if cap(st.k32) < kl {
+		st.k32 = make([]uint32, kl)
+		st.root = make([]uint32, kl+1)
+	}
+	k32 := st.k32[:kl]
+	root := st.root[:kl+1]
 	for i := 0; i < kl; i++ {
-		root[i], ri = ri*257, ri+1
-		xs1, xs2, xs3, xs4 = xorShift(xs1, xs2, xs3, xs4)
-		k32[i] = ((uint32(key[i]) + 1) * ((xs4 & 255) + 1)) % s
+		root[i] = (uint32(i) % g.roots) * g.stride
+		k32[i] = g.k32(key, i, st.rotation())
+	}
+	// the root indexes wrap so that keys may be longer than the root queue
+	ri := uint32(kl) % g.roots
+	root[kl], ri = ri*g.stride, ri+1
+	if ri >= g.roots {
+		ri = 0
+	}
+
+	st.key, st.invert = key, invert
+	st.k32, st.root, st.ri = k32, root, ri
+}
+
+// seek moves the state to offset symbols into the message without ciphering
+// the symbols before it. The key product at any position depends only on the
+// key and the position: the root queue is just the indexes offset..offset+kl
+// mod the number of roots, and the rotation happens each time the root index
+// wraps, so the xorShift generator only needs to be stepped once per rotation
+// that has occurred.
+func (st *state) seek(offset int64) {
+	st.start(st.key, st.invert)
+	st.jump(st.base + offset)
+}
+
+// jump moves a state fresh from start to offset symbols into the schedule.
+func (st *state) jump(offset int64) {
+	g := st.g
+	kl := int64(len(st.k32))
+	roots := int64(g.roots)
+
+	// the first rotation happens after the symbol that pushes the last root
+	// index onto the queue
+	first := ((roots-2-kl)%roots + roots) % roots
+	if offset > first {
+		for r := (offset-1-first)/roots + 1; r > 0; r-- {
+			for j := 0; j < len(st.k32)-1; j++ {
+				st.k32[j] = g.k32(st.key, j, st.rotation())
+			}
+		}
+	}
+
+	pos := offset % roots
+	for j := range st.root {
+		st.root[j] = uint32((pos+int64(j))%roots) * g.stride
+	}
+	st.ri = uint32((pos + kl + 1) % roots)
+}
+
+// rotation returns the next rotation value. Without a RotationSource the
+// xorShift generator is stepped in place, which saves an allocation for every
+// message.
+func (st *state) rotation() uint32 {
+	var v uint32
+	if st.rot != nil {
+		v = st.rot.Next()
+	} else {
+		st.xs1, st.xs2, st.xs3, st.xs4 = xorShift(st.xs1, st.xs2, st.xs3, st.xs4)
+		v = st.xs4
+	}
+	if st.masked {
+		v ^= st.mask.Next()
 	}
-	root[kl], ri = ri*257, ri+1
+	return v
+}
+
+// cipher applies the key to input, writing to output, and advances the state
+// by len(input) bytes. output must be at least as long as input. Only a group
+// with one byte symbols can cipher bytes directly.
+func (st *state) cipher(output, input []byte) {
+	if st.g.pm == nil {
+		panic("cyclicKey: group symbols are not bytes, use CipherSymbols")
+	}
+	p := st.g.p
+	for len(input) > 0 {
+		n := len(input)
+		if n > len(st.kps) {
+			n = len(st.kps)
+		}
+		kps := st.kps[:n]
+		st.products(kps)
+		for i, kp := range kps {
+			output[i] = byte((((uint32(input[i]) + 1) * kp) % p) - 1)
+		}
+		input, output = input[n:], output[n:]
+	}
+}
+
+// cipherSymbols is cipher for symbols in [0, p-2].
+func (st *state) cipherSymbols(output, input []uint32) {
+	g := st.g
+	for len(input) > 0 {
+		n := len(input)
+		if n > len(st.kps) {
+			n = len(st.kps)
+		}
+		kps := st.kps[:n]
+		st.products(kps)
+		if g.pm != nil {
+			for i, kp := range kps {
+				output[i] = (((input[i] + 1) * kp) % g.p) - 1
+			}
+		} else {
+			for i, e := range kps {
+				output[i] = g.exp[(g.log[input[i]+1]+e)%g.s] - 1
+			}
+		}
+		input, output = input[n:], output[n:]
+	}
+}
+
+// products fills kps with the key products for the next len(kps) bytes of the
+// message, with the inversion already applied, and advances the state past
+// them.
+func (st *state) products(kps []uint32) {
+	if st.g.pm == nil {
+		st.exponents(kps)
+		return
+	}
+	key, invert, k32, root, ri := st.key, st.invert, st.k32, st.root, st.ri
+	pmTbl, invTbl, p, s, roots := st.g.pm, st.g.inv, st.g.p, st.g.s, st.g.roots
+	kl := len(key)
+	p64 := uint64(p)
 
-	//main
-	cl := len(input)
-	output := make([]byte, cl)
 	j := 0
-	for i := 0; i < cl; i++ {
+	for i := range kps {
 		// outer loop : iterates over each byte of the message
 		doMod := uint8(0)
-		kp := uint32(1)
+		kp64 := uint64(1)
 		for j = 0; j < len(key); j++ {
 			// inner loop : iterates over each byte of the key
-			kp *= pmTbl[root[j]+k32[j]]
+			kp64 *= uint64(pmTbl[root[j]+k32[j]])
 			if doMod == 2 {
-				kp = kp % p
+				kp64 = kp64 % p64
 				doMod = 0
 			} else {
 				doMod++
@@ -91,8 +273,9 @@ func Cipher(input, key []byte, invert bool) []byte {
 			root[j] = root[j+1]
 		}
 		if doMod != 0 {
-			kp = kp % p
+			kp64 = kp64 % p64
 		}
+		kp := uint32(kp64)
 		if invert {
 			kp = uint32(invTbl[kp-1]) + 1
 		} else {
@@ -103,43 +286,59 @@ func Cipher(input, key []byte, invert bool) []byte {
 			doMod = uint8(invTbl[kp-1]) - 1
 		}
 		// push next primative root on queue
-		root[kl], ri = ri*257, ri+1
+		root[kl], ri = ri*p, ri+1
 		// do key rotation
-		if ri > 127 {
+		if ri >= roots {
 			ri = uint32(0) //reset root index
 			for j = 0; j < kl-1; j++ {
-				xs1, xs2, xs3, xs4 = xorShift(xs1, xs2, xs3, xs4)
-				k32[j] = ((uint32(key[j]) + 1) * ((xs4 & 255) + 1)) % s
+				k32[j] = ((uint32(key[j]) + 1) * ((st.rotation() % s) + 1)) % s
+			}
+		}
+		kps[i] = kp
+	}
+
+	st.ri = ri
+}
+
+// exponents is products for a group without power mod tables. It follows the
+// same root queue and rotation, but fills kps with the exponent of each key
+// product: the sum of the root exponents times the key exponents, mod s.
+func (st *state) exponents(kps []uint32) {
+	g := st.g
+	key, k32, root, ri := st.key, st.k32, st.root, st.ri
+	s := uint64(g.s)
+	kl := len(k32)
+
+	for i := range kps {
+		e := uint64(0)
+		for j := 0; j < kl; j++ {
+			e += uint64(g.rootExp[root[j]]) * uint64(k32[j])
+			root[j] = root[j+1]
+		}
+		e %= s
+		if st.invert {
+			e = (s - e) % s
+		}
+		root[kl], ri = ri, ri+1
+		if ri >= g.roots {
+			ri = 0
+			for j := 0; j < kl-1; j++ {
+				k32[j] = g.k32(key, j, st.rotation())
 			}
 		}
-		output[i] = byte((((uint32(input[i]) + 1) * kp) % p) - 1)
+		kps[i] = uint32(e)
 	}
-	return output
+
+	st.ri = ri
 }
 
 // Number of bytes in a single key
 var KeyLength = 10
 
 // Generates a set of keys. The size of the set is defined by keys. The length
-// of each key is defined by the package variable KeyLength.
+// of each key is defined by the package variable KeyLength. The last key is the
+// compound key and must be applied with invert set to true.
 func GenerateKeyset(keys int) [][]byte {
-	keyset := make([][]byte, keys)
-	compoundKey := make([]uint32, KeyLength)
-
-	for i := 0; i < keys-1; i++ {
-		keyset[i] = make([]byte, KeyLength)
-		_, err := rand.Read(keyset[i])
-		if err != nil {
-			panic(err)
-		}
-		for j := 0; j < KeyLength; j += 1 {
-			//Technical note, if keys > 16,777,216 compound key could overflow
-			compoundKey[j] += uint32(keyset[i][j]) + 1
-		}
-	}
-	keyset[keys-1] = make([]byte, KeyLength)
-	for j := 0; j < KeyLength; j += 1 {
-		keyset[keys-1][j] += byte((compoundKey[j] % s) - 1)
-	}
-	return keyset
+	pr := DefaultParams()
+	return pr.GenerateKeyset(keys)
 }
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"crypto/aes"
 	"crypto/rand"
-	"math"
 	"testing"
 )
 
@@ -141,10 +140,9 @@ func TestBreakIt(t *testing.T) {
 	// setup key and message
 	m := make([]byte, 5)
 	rand.Read(m)
-	reset := KeyLength
-	KeyLength = 2
-	keys := GenerateKeyset(3)
-	KeyLength = reset
+	params := DefaultParams()
+	params.KeyLength = 2
+	keys := params.GenerateKeyset(3)
 
 	// find the keys that were actually used
 	// note that k32 will not be used again until
@@ -282,3 +280,31 @@ func TestBreakIt(t *testing.T) {
 	t.Error("Failed to recover key")
 
 }
+
+// zeroSource rotates every key symbol by a multiplier of 1.
+type zeroSource struct{}
+
+func (zeroSource) Next() uint32 { return 0 }
+
+func TestProductOverflow(t *testing.T) {
+	// With no rotation a key symbol of 127 is used as the exponent 128, and
+	// every primitive root raised to 128 is -1, the largest reduced value, 256.
+	// Four of those multiplied before the mod is 2^32, which wrapped a uint32
+	// product to 0.
+	pr := DefaultParams()
+	pr.Rotation = func() RotationSource { return zeroSource{} }
+	m := make([]byte, 100)
+	for kl := 1; kl <= 12; kl++ {
+		key := bytes.Repeat([]byte{127}, kl)
+		// ciphering zeros leaves kp-1, and kp is (-1)^kl
+		want := byte(0)
+		if kl%2 == 1 {
+			want = 255
+		}
+		for i, c := range pr.Cipher(m, key, false) {
+			if c != want {
+				t.Fatal("Wrong key product at", i, "with key length", kl)
+			}
+		}
+	}
+}
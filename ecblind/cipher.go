@@ -0,0 +1,67 @@
+package ecblind
+
+import (
+	"io"
+	"math/big"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+// Cipher is the ecblind backend as a cyclicKey.CyclicCipher, registered as
+// "ecblind". Its keys are Key values.
+var Cipher cyclicKey.CyclicCipher = backend{}
+
+func init() {
+	cyclicKey.Register(Cipher)
+}
+
+type backend struct{}
+
+func (backend) Name() string {
+	return "ecblind"
+}
+
+func (backend) GenerateKeyset(n int, rnd io.Reader) ([]cyclicKey.CipherKey, error) {
+	keys, err := NewKeyset(n, rnd)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]cyclicKey.CipherKey, len(keys))
+	for i, k := range keys {
+		out[i] = k
+	}
+	return out, nil
+}
+
+func (backend) Apply(key cyclicKey.CipherKey, msg []byte) ([]byte, error) {
+	k, ok := key.(Key)
+	if !ok {
+		return nil, cyclicKey.ErrKeyType
+	}
+	return k.apply(msg)
+}
+
+// EncodeKey writes the scalar, which is already ElementLen bytes.
+func (backend) EncodeKey(key cyclicKey.CipherKey) ([]byte, error) {
+	k, ok := key.(Key)
+	if !ok {
+		return nil, cyclicKey.ErrKeyType
+	}
+	return append([]byte(nil), k.Scalar...), nil
+}
+
+// DecodeKey rejects a scalar outside [1, n-1].
+func (backend) DecodeKey(data []byte) (cyclicKey.CipherKey, error) {
+	if len(data) != ElementLen {
+		return nil, cyclicKey.ErrKeyEncoding
+	}
+	s := new(big.Int).SetBytes(data)
+	if s.Sign() == 0 || s.Cmp(params.N) >= 0 {
+		return nil, cyclicKey.ErrKeyEncoding
+	}
+	return Key{Scalar: append([]byte(nil), data...)}, nil
+}
+
+func (backend) MaxMessageSize() int {
+	return MaxMessageSize
+}
@@ -0,0 +1,82 @@
+package ecblind
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestCipherStates(t *testing.T) {
+	c, err := cyclicKey.Lookup("ecblind")
+	if err != nil || c != Cipher {
+		t.Fatal("ecblind is not registered", err)
+	}
+	if c.MaxMessageSize() != MaxMessageSize {
+		t.Error("Wrong MaxMessageSize", c.MaxMessageSize())
+	}
+	keys, err := c.GenerateKeyset(4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("by id")
+	state := msg
+	for i, k := range keys {
+		b, err := cyclicKey.MarshalKey(c, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, dk, err := cyclicKey.UnmarshalKey(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state, err = c.Apply(dk, state); err != nil {
+			t.Fatal(err)
+		}
+		// the sealed part is carried through every hop unchanged
+		if i > 0 && i < len(keys)-1 && len(state) != StateLen {
+			t.Fatal("State after", i+1, "keys is", len(state), "bytes")
+		}
+	}
+	if !bytes.Equal(msg, state) {
+		t.Error("Did not cycle")
+	}
+	if _, err := c.Apply(keys[0], make([]byte, MaxMessageSize+1)); err != cyclicKey.ErrMessageSize {
+		t.Error("Expected ErrMessageSize, got", err)
+	}
+	if _, err := c.Apply(cyclicKey.Key{}, msg); err != cyclicKey.ErrKeyType {
+		t.Error("Expected ErrKeyType, got", err)
+	}
+}
+
+func TestCipherBadPoints(t *testing.T) {
+	key := RandomKeyset(3)[0]
+	// states a peer could send whose x coordinate is not a point: x >= p and
+	// an x with no point
+	bad := [][]byte{bytes.Repeat([]byte{0xff}, StateLen)}
+	for x := byte(1); len(bad) < 2; x++ {
+		b := make([]byte, StateLen)
+		b[0], b[ElementLen-1] = 1, x
+		if lift(new(big.Int).SetBytes(b[:ElementLen])) == nil {
+			bad = append(bad, b)
+		}
+	}
+	for _, state := range bad {
+		if _, err := Cipher.Apply(key, state); err != cyclicKey.ErrState {
+			t.Errorf("Expected ErrState for x %x, got %v", state[:ElementLen], err)
+		}
+	}
+}
+
+func TestCipherBadScalars(t *testing.T) {
+	// 0 and the order of the curve are not scalars a key can have
+	for _, s := range [][]byte{make([]byte, ElementLen), params.N.FillBytes(make([]byte, ElementLen))} {
+		if _, err := Cipher.Apply(Key{Scalar: s}, []byte("x")); err != cyclicKey.ErrKeyEncoding {
+			t.Errorf("Apply with scalar %x expected ErrKeyEncoding, got %v", s, err)
+		}
+		if _, err := Cipher.DecodeKey(s); err != cyclicKey.ErrKeyEncoding {
+			t.Errorf("Decoding scalar %x expected ErrKeyEncoding, got %v", s, err)
+		}
+	}
+}
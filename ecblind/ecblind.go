@@ -0,0 +1,240 @@
+// Package ecblind is a cyclic keyset backend built on scalar multiplication on
+// the P-256 curve. A key is a scalar k and applying it multiplies the point a
+// state starts with by k. The scalars of a keyset multiply to 1 mod the order
+// of the curve, so applying all of them, in any order, returns the point the
+// message was sealed under.
+//
+// Like bigprime it mirrors the keyset API of cyclicKey: NewKeyset,
+// RandomKeyset and Key.Apply. Keys are 32 bytes. Only the x coordinate of a
+// point is kept, which is all crypto/ecdh returns; x(k*P) = x(k*-P), so it
+// doesn't matter which of the two points with that x it is lifted back to.
+//
+// A message is not embedded in the point. The first Apply picks a random
+// point, seals the message with AES-GCM under a key hashed from its x
+// coordinate, and the state is the x coordinate followed by the sealed
+// message. The keys only blind the point and leave the sealed part as it is.
+// Once every key has been applied the point is back where it started and the
+// message opens. The message is padded to MaxMessageSize before it is sealed,
+// so every state is StateLen bytes and the hops can't see how long it is.
+package ecblind
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+// ElementLen is the length in bytes of an x coordinate, the blinded part of a
+// state.
+const ElementLen = 32
+
+// MaxMessageSize is the longest message a state can carry.
+const MaxMessageSize = 1024
+
+// StateLen is the length in bytes of every state: the x coordinate, then the
+// sealed message, its length and padding.
+const StateLen = ElementLen + sealedLen
+
+// sealedLen is the length of the sealed part of a state, a 2 byte length, the
+// padded message and the GCM tag.
+const sealedLen = 2 + MaxMessageSize + tagLen
+
+// tagLen is the length of a GCM tag.
+const tagLen = 16
+
+var (
+	params = elliptic.P256().Params()
+	// sqrtExp is (p+1)/4, square roots are a single exponentiation because p
+	// is 3 mod 4
+	sqrtExp = new(big.Int).Rsh(new(big.Int).Add(params.P, big.NewInt(1)), 2)
+	three   = big.NewInt(3)
+	// gcmNonce is the nonce every message is sealed with
+	gcmNonce = make([]byte, 12)
+)
+
+// Key is one key of a keyset, a scalar in [1, n-1] written big endian in 32
+// bytes.
+type Key struct {
+	Scalar []byte
+}
+
+// Keyset is a cyclic set of keys.
+type Keyset []Key
+
+// Apply multiplies the point at the start of the state by the key's scalar.
+// The input is either a message of at most MaxMessageSize bytes, which is
+// sealed first, or a state of StateLen bytes from an earlier Apply. When the
+// message opens under the new point, as it does once the last key of a keyset
+// is applied, the message is returned instead of the state. It panics if the
+// input is neither, or if the key is not a valid scalar. Cipher.Apply returns
+// an error instead.
+func (k Key) Apply(state []byte) []byte {
+	out, err := k.apply(state)
+	switch err {
+	case nil:
+		return out
+	case cyclicKey.ErrMessageSize:
+		panic("ecblind: message longer than MaxMessageSize")
+	case cyclicKey.ErrState:
+		panic("ecblind: state is not a point on the curve")
+	case cyclicKey.ErrKeyEncoding:
+		panic("ecblind: invalid key")
+	}
+	panic(err)
+}
+
+func (k Key) apply(state []byte) ([]byte, error) {
+	switch {
+	case len(state) == StateLen:
+	case len(state) <= MaxMessageSize:
+		state = seal(state)
+	default:
+		return nil, cyclicKey.ErrMessageSize
+	}
+	// lift gives nil for an x with no point, NewPublicKey rejects x >= p
+	pt := lift(new(big.Int).SetBytes(state[:ElementLen]))
+	if pt == nil {
+		return nil, cyclicKey.ErrState
+	}
+	pub, err := ecdh.P256().NewPublicKey(pt)
+	if err != nil {
+		return nil, cyclicKey.ErrState
+	}
+	priv, err := ecdh.P256().NewPrivateKey(k.Scalar)
+	if err != nil {
+		return nil, cyclicKey.ErrKeyEncoding
+	}
+	x, err := priv.ECDH(pub)
+	if err != nil {
+		return nil, err
+	}
+	if msg, ok := open(x, state[ElementLen:]); ok {
+		return msg, nil
+	}
+	out := make([]byte, StateLen)
+	copy(out, x)
+	copy(out[ElementLen:], state[ElementLen:])
+	return out, nil
+}
+
+// lift returns the uncompressed encoding of a point with x coordinate x, or
+// nil if there isn't one.
+func lift(x *big.Int) []byte {
+	y2 := curveRHS(x)
+	y := new(big.Int).Exp(y2, sqrtExp, params.P)
+	check := new(big.Int).Mul(y, y)
+	if check.Mod(check, params.P).Cmp(y2) != 0 {
+		return nil
+	}
+	b := make([]byte, 1+2*ElementLen)
+	b[0] = 4
+	x.FillBytes(b[1 : 1+ElementLen])
+	y.FillBytes(b[1+ElementLen:])
+	return b
+}
+
+// curveRHS returns x^3 - 3x + b mod p.
+func curveRHS(x *big.Int) *big.Int {
+	r := new(big.Int).Mul(x, x)
+	r.Mul(r, x)
+	r.Sub(r, new(big.Int).Mul(three, x))
+	r.Add(r, params.B)
+	return r.Mod(r, params.P)
+}
+
+// seal returns a state for msg: the x coordinate of a random point and msg
+// sealed under it. About half of all x values less than p have a point, so
+// this takes two tries on average.
+func seal(msg []byte) []byte {
+	state := make([]byte, StateLen)
+	x := new(big.Int)
+	for {
+		if _, err := rand.Read(state[:ElementLen]); err != nil {
+			panic(err)
+		}
+		x.SetBytes(state[:ElementLen])
+		if x.Sign() != 0 && x.Cmp(params.P) < 0 && lift(x) != nil {
+			break
+		}
+	}
+	plain := make([]byte, sealedLen-tagLen)
+	binary.BigEndian.PutUint16(plain, uint16(len(msg)))
+	copy(plain[2:], msg)
+	aead(state[:ElementLen]).Seal(state[ElementLen:ElementLen], gcmNonce, plain, nil)
+	return state
+}
+
+// open returns the message sealed under x, if it opens.
+func open(x, sealed []byte) ([]byte, bool) {
+	plain, err := aead(x).Open(nil, gcmNonce, sealed, nil)
+	if err != nil {
+		return nil, false
+	}
+	l := int(binary.BigEndian.Uint16(plain))
+	if l > MaxMessageSize {
+		return nil, false
+	}
+	return plain[2 : 2+l], true
+}
+
+// aead returns AES-256-GCM keyed with a hash of x. Every message is sealed
+// under a new random point, so each key seals once and the nonce can be
+// fixed, see gcmNonce.
+func aead(x []byte) cipher.AEAD {
+	h := sha256.New()
+	h.Write([]byte("ecblind seal"))
+	h.Write(x)
+	block, err := aes.NewCipher(h.Sum(nil))
+	if err != nil {
+		panic(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return gcm
+}
+
+// NewKeyset generates a set of n keys. Randomness is read from rnd, or from
+// crypto/rand if rnd is nil.
+func NewKeyset(n int, rnd io.Reader) (Keyset, error) {
+	if n < 3 {
+		return nil, cyclicKey.KeyCountError(n)
+	}
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	keys := make(Keyset, n)
+	prod := big.NewInt(1)
+	nm1 := new(big.Int).Sub(params.N, big.NewInt(1))
+	for i := 0; i < n-1; i++ {
+		s, err := rand.Int(rnd, nm1)
+		if err != nil {
+			return nil, &cyclicKey.EntropyError{Err: err}
+		}
+		s.Add(s, big.NewInt(1))
+		keys[i].Scalar = s.FillBytes(make([]byte, ElementLen))
+		prod.Mul(prod, s).Mod(prod, params.N)
+	}
+	last := new(big.Int).ModInverse(prod, params.N)
+	keys[n-1].Scalar = last.FillBytes(make([]byte, ElementLen))
+	return keys, nil
+}
+
+// RandomKeyset generates a set of keys using crypto/rand. It panics where
+// NewKeyset would return an error.
+func RandomKeyset(n int) Keyset {
+	keys, err := NewKeyset(n, nil)
+	if err != nil {
+		panic(err)
+	}
+	return keys
+}
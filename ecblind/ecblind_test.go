@@ -0,0 +1,74 @@
+package ecblind
+
+import (
+	"bytes"
+	"crypto/rand"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/AdamColton/cyclicKey"
+)
+
+func TestCycle(t *testing.T) {
+	for _, l := range []int{0, 1, 100, MaxMessageSize} {
+		m := make([]byte, l)
+		rand.Read(m)
+		for n := 3; n < 7; n++ {
+			keys := RandomKeyset(n)
+			c := m
+			for i, k := range mrand.Perm(n) {
+				c = keys[k].Apply(c)
+				if i < n-1 && len(c) != StateLen {
+					t.Fatal("Intermediate state is", len(c), "bytes")
+				}
+			}
+			if !bytes.Equal(m, c) {
+				t.Error("Did not cycle with", n, "keys and length", l)
+			}
+		}
+	}
+}
+
+func TestEqualMessages(t *testing.T) {
+	// every message is sealed under a new random point, so the same message
+	// gives different states
+	k := RandomKeyset(3)[0]
+	for _, l := range []int{0, 1, MaxMessageSize} {
+		m := make([]byte, l)
+		rand.Read(m)
+		if bytes.Equal(k.Apply(m), k.Apply(m)) {
+			t.Error("Equal messages of length", l, "gave equal states")
+		}
+	}
+}
+
+func TestTampered(t *testing.T) {
+	// a hop that changes the sealed part leaves a state that never opens
+	keys := RandomKeyset(3)
+	m := []byte("by id")
+	for _, i := range []int{ElementLen, StateLen / 2, StateLen - 1} {
+		c := keys[1].Apply(keys[0].Apply(m))
+		c[i] ^= 1
+		if c = keys[2].Apply(c); len(c) != StateLen {
+			t.Error("Changed byte", i, "still opened")
+		}
+	}
+}
+
+func TestNewKeyset(t *testing.T) {
+	if _, err := NewKeyset(1, nil); err != cyclicKey.KeyCountError(1) {
+		t.Error("Expected KeyCountError, got", err)
+	}
+	if _, err := NewKeyset(3, bytes.NewReader(make([]byte, 10))); err == nil {
+		t.Error("Expected an error from a short source")
+	}
+}
+
+func TestOversized(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic")
+		}
+	}()
+	RandomKeyset(3)[0].Apply(make([]byte, MaxMessageSize+1))
+}
@@ -0,0 +1,293 @@
+package cyclicKey
+
+import (
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash/fnv"
+)
+
+// Errors returned when decoding keys and keysets
+var (
+	ErrKeyEncoding     = errors.New("cyclicKey: malformed key encoding")
+	ErrEncodingVersion = errors.New("cyclicKey: unsupported key encoding version")
+	ErrParams          = errors.New("cyclicKey: key was made for a different parameter set")
+)
+
+// encodingVersion is written into the header of every encoded key. It occupies
+// the top 3 bits of the first header byte. Version 1 had a single header byte
+// with a 4 bit tag.
+const encodingVersion = 2
+
+// PEM block types used by the text encodings
+const (
+	keyPEMType    = "CYCLIC KEY"
+	keysetPEMType = "CYCLIC KEYSET"
+	paramsHeader  = "Params"
+)
+
+// fingerprint identifies the parameter set keys are made for: the prime, the
+// primitive root the tables are built on and the rotation seeds. A rotation
+// source can't be compared directly, so when there is one the first values it
+// produces stand in for it. A key is only meaningful under the parameters that
+// produced it.
+func (pr *Params) fingerprint() uint32 {
+	g := pr.group()
+	h := fnv.New32a()
+	b := make([]byte, 4)
+	seeds := pr.seeds()
+	vals := []uint32{g.p, g.lpr, seeds[0], seeds[1], seeds[2], seeds[3]}
+	if pr.Rotation != nil {
+		src := pr.Rotation()
+		for i := 0; i < 4; i++ {
+			vals = append(vals, src.Next())
+		}
+	}
+	for _, v := range vals {
+		binary.LittleEndian.PutUint32(b, v)
+		h.Write(b)
+	}
+	return h.Sum32()
+}
+
+// tag folds the fingerprint down to the 12 bits that fit in the binary
+// header. A key made under another parameter set still passes it 1 time in
+// 4096, so it catches mixed up keys rather than proving where a key came from.
+// The PEM encodings carry the whole 32 bit fingerprint.
+func (pr *Params) tag() uint16 {
+	f := pr.fingerprint()
+	return uint16((f ^ f>>12 ^ f>>24) & 0xfff)
+}
+
+// headerLen is the length of the header in front of the bytes of a key.
+const headerLen = 2
+
+// MarshalBinary encodes the key as a 2 byte header followed by the key bytes,
+// so a 10 byte key encodes to 12 bytes. The first byte holds the encoding
+// version (bits 7-5), the top 4 bits of a 12 bit tag for the default parameter
+// set (bits 4-1) and the inversion flag (bit 0); the second holds the low 8
+// bits of the tag.
+func (k Key) MarshalBinary() ([]byte, error) {
+	return defaultParams.EncodeKey(k)
+}
+
+// EncodeKey encodes the key in the same form as Key.MarshalBinary, tagged with
+// this parameter set.
+func (pr *Params) EncodeKey(k Key) ([]byte, error) {
+	if len(k.Data) == 0 {
+		return nil, ErrKeyEncoding
+	}
+	tag := pr.tag()
+	b := make([]byte, len(k.Data)+headerLen)
+	b[0] = encodingVersion<<5 | byte(tag>>8)<<1
+	if k.Invert {
+		b[0] |= 1
+	}
+	b[1] = byte(tag)
+	copy(b[headerLen:], k.Data)
+	return b, nil
+}
+
+// UnmarshalBinary decodes a key written by MarshalBinary. It rejects keys with
+// an unknown version or that were made for a different parameter set.
+func (k *Key) UnmarshalBinary(data []byte) error {
+	key, err := defaultParams.DecodeKey(data)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
+
+// DecodeKey decodes a key written by EncodeKey. It rejects keys with an unknown
+// version, and returns ErrParams for keys whose tag doesn't match this
+// parameter set, see tag.
+func (pr *Params) DecodeKey(data []byte) (Key, error) {
+	if len(data) <= headerLen {
+		return Key{}, ErrKeyEncoding
+	}
+	if data[0]>>5 != encodingVersion {
+		return Key{}, ErrEncodingVersion
+	}
+	if (len(data)-headerLen)%pr.group().width != 0 {
+		return Key{}, ErrKeyEncoding
+	}
+	if uint16(data[0]>>1&15)<<8|uint16(data[1]) != pr.tag() {
+		return Key{}, ErrParams
+	}
+	k := Key{
+		Data:   make([]byte, len(data)-headerLen),
+		Invert: data[0]&1 == 1,
+	}
+	copy(k.Data, data[headerLen:])
+	return k, nil
+}
+
+// MarshalText armors the binary encoding as a PEM block. The block carries the
+// full parameter fingerprint in a header.
+func (k Key) MarshalText() ([]byte, error) {
+	return defaultParams.EncodeKeyText(k)
+}
+
+// UnmarshalText decodes a key written by MarshalText.
+func (k *Key) UnmarshalText(text []byte) error {
+	key, err := defaultParams.DecodeKeyText(text)
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
+
+// EncodeKeyText encodes the key in the same form as Key.MarshalText, for this
+// parameter set.
+func (pr *Params) EncodeKeyText(k Key) ([]byte, error) {
+	b, err := pr.EncodeKey(k)
+	if err != nil {
+		return nil, err
+	}
+	return pr.armor(keyPEMType, b), nil
+}
+
+// DecodeKeyText decodes a key written by EncodeKeyText. The block must carry
+// the fingerprint of this parameter set.
+func (pr *Params) DecodeKeyText(text []byte) (Key, error) {
+	b, err := pr.unarmor(keyPEMType, text)
+	if err != nil {
+		return Key{}, err
+	}
+	return pr.DecodeKey(b)
+}
+
+// MarshalBinary encodes the keyset as the number of keys and the key length,
+// both as uvarints, followed by the binary encoding of each key.
+func (ks Keyset) MarshalBinary() ([]byte, error) {
+	return defaultParams.EncodeKeyset(ks)
+}
+
+// UnmarshalBinary decodes a keyset written by MarshalBinary.
+func (ks *Keyset) UnmarshalBinary(data []byte) error {
+	keyset, err := defaultParams.DecodeKeyset(data)
+	if err != nil {
+		return err
+	}
+	*ks = keyset
+	return nil
+}
+
+// EncodeKeyset encodes the keyset in the same form as Keyset.MarshalBinary,
+// with every key tagged with this parameter set.
+func (pr *Params) EncodeKeyset(ks Keyset) ([]byte, error) {
+	if len(ks) == 0 {
+		return nil, ErrKeyEncoding
+	}
+	kl := len(ks[0].Data)
+	b := make([]byte, 2*binary.MaxVarintLen64, 2*binary.MaxVarintLen64+len(ks)*(kl+headerLen))
+	n := binary.PutUvarint(b, uint64(len(ks)))
+	n += binary.PutUvarint(b[n:], uint64(kl))
+	b = b[:n]
+	for _, k := range ks {
+		if len(k.Data) != kl {
+			return nil, ErrKeyEncoding
+		}
+		kb, err := pr.EncodeKey(k)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, kb...)
+	}
+	return b, nil
+}
+
+// DecodeKeyset decodes a keyset written by EncodeKeyset.
+func (pr *Params) DecodeKeyset(data []byte) (Keyset, error) {
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, ErrKeyEncoding
+	}
+	data = data[n:]
+	kl, n := binary.Uvarint(data)
+	if n <= 0 || kl == 0 {
+		return nil, ErrKeyEncoding
+	}
+	data = data[n:]
+	// each key takes kl+headerLen bytes; check kl against the data before
+	// adding to it, and divide rather than multiply, the product can overflow
+	if kl >= uint64(len(data)) {
+		return nil, ErrKeyEncoding
+	}
+	size := kl + headerLen
+	if count != uint64(len(data))/size || uint64(len(data))%size != 0 {
+		return nil, ErrKeyEncoding
+	}
+	keyset := make(Keyset, count)
+	for i := range keyset {
+		k, err := pr.DecodeKey(data[:size])
+		if err != nil {
+			return nil, err
+		}
+		keyset[i] = k
+		data = data[size:]
+	}
+	return keyset, nil
+}
+
+// MarshalText armors the binary encoding of the keyset as a PEM block.
+func (ks Keyset) MarshalText() ([]byte, error) {
+	return defaultParams.EncodeKeysetText(ks)
+}
+
+// UnmarshalText decodes a keyset written by MarshalText.
+func (ks *Keyset) UnmarshalText(text []byte) error {
+	keyset, err := defaultParams.DecodeKeysetText(text)
+	if err != nil {
+		return err
+	}
+	*ks = keyset
+	return nil
+}
+
+// EncodeKeysetText encodes the keyset in the same form as Keyset.MarshalText,
+// for this parameter set.
+func (pr *Params) EncodeKeysetText(ks Keyset) ([]byte, error) {
+	b, err := pr.EncodeKeyset(ks)
+	if err != nil {
+		return nil, err
+	}
+	return pr.armor(keysetPEMType, b), nil
+}
+
+// DecodeKeysetText decodes a keyset written by EncodeKeysetText. The block
+// must carry the fingerprint of this parameter set.
+func (pr *Params) DecodeKeysetText(text []byte) (Keyset, error) {
+	b, err := pr.unarmor(keysetPEMType, text)
+	if err != nil {
+		return nil, err
+	}
+	return pr.DecodeKeyset(b)
+}
+
+func (pr *Params) armor(blockType string, b []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type: blockType,
+		Headers: map[string]string{
+			paramsHeader: fmt.Sprintf("%08x", pr.fingerprint()),
+		},
+		Bytes: b,
+	})
+}
+
+// unarmor returns the bytes of a PEM block of the given type. The block must
+// have a Params header with the fingerprint of the parameter set, a block
+// without one returns ErrParams.
+func (pr *Params) unarmor(blockType string, text []byte) ([]byte, error) {
+	block, _ := pem.Decode(text)
+	if block == nil || block.Type != blockType {
+		return nil, ErrKeyEncoding
+	}
+	if fp, ok := block.Headers[paramsHeader]; !ok || fp != fmt.Sprintf("%08x", pr.fingerprint()) {
+		return nil, ErrParams
+	}
+	return block.Bytes, nil
+}
@@ -0,0 +1,193 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestKeyBinaryRoundTrip(t *testing.T) {
+	for _, k := range RandomKeyset(4) {
+		b, err := k.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != KeyLength+2 {
+			t.Error("Expected", KeyLength+2, "bytes, got", len(b))
+		}
+		var got Key
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+		if got.Invert != k.Invert || !bytes.Equal(got.Data, k.Data) {
+			t.Error("Key did not round trip")
+		}
+	}
+}
+
+func TestKeyTextRoundTrip(t *testing.T) {
+	k := RandomKeyset(3)[0]
+	txt, err := k.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(txt), "-----BEGIN CYCLIC KEY-----") {
+		t.Error("Unexpected armor:", string(txt))
+	}
+	var got Key
+	if err := got.UnmarshalText(txt); err != nil {
+		t.Fatal(err)
+	}
+	if got.Invert != k.Invert || !bytes.Equal(got.Data, k.Data) {
+		t.Error("Key did not round trip")
+	}
+}
+
+func TestKeysetRoundTrip(t *testing.T) {
+	ks := RandomKeyset(5)
+	txt, err := ks.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Keyset
+	if err := got.UnmarshalText(txt); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(ks) {
+		t.Fatal("Wrong number of keys")
+	}
+	for i := range ks {
+		if got[i].Invert != ks[i].Invert || !bytes.Equal(got[i].Data, ks[i].Data) {
+			t.Error("Key", i, "did not round trip")
+		}
+	}
+}
+
+func TestKeysetDecodeRejects(t *testing.T) {
+	b, _ := RandomKeyset(3).MarshalBinary()
+	var ks Keyset
+	for _, bad := range [][]byte{nil, b[:len(b)-1], append(b, 0)} {
+		if err := ks.UnmarshalBinary(bad); err != ErrKeyEncoding {
+			t.Error("Expected ErrKeyEncoding, got", err)
+		}
+	}
+
+	// count*(kl+2) wraps around to the length of the data
+	crafted := binary.AppendUvarint(nil, 1<<63+5)
+	crafted = binary.AppendUvarint(crafted, 1)
+	crafted = append(crafted, make([]byte, 10)...)
+	if err := ks.UnmarshalBinary(crafted); err != ErrKeyEncoding {
+		t.Error("Expected ErrKeyEncoding for an overflowing count, got", err)
+	}
+
+	// kl+2 wraps around
+	crafted = binary.AppendUvarint(nil, 1)
+	crafted = binary.AppendUvarint(crafted, ^uint64(0))
+	crafted = append(crafted, 1, 2, 3)
+	if err := ks.UnmarshalBinary(crafted); err != ErrKeyEncoding {
+		t.Error("Expected ErrKeyEncoding for an overflowing key length, got", err)
+	}
+}
+
+func TestParamsTag(t *testing.T) {
+	key := RandomKeyset(3)[0]
+	pr := DefaultParams()
+	var mismatch, collide bool
+	for i := uint32(1); !(mismatch && collide); i++ {
+		pr.Seeds[0] = i
+		b, err := pr.EncodeKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = defaultParams.DecodeKey(b)
+		if pr.tag() == defaultParams.tag() {
+			// the 12 bit tag can't tell these apart
+			if err != nil {
+				t.Error("Expected a colliding tag to decode, got", err)
+			}
+			collide = true
+		} else {
+			if err != ErrParams {
+				t.Error("Expected ErrParams for seeds", pr.Seeds, "got", err)
+			}
+			mismatch = true
+		}
+	}
+}
+
+func TestKeyDecodeRejects(t *testing.T) {
+	b, _ := RandomKeyset(3)[0].MarshalBinary()
+
+	var k Key
+	bad := append([]byte{}, b...)
+	bad[0] = (bad[0] & 31) | 3<<5
+	if err := k.UnmarshalBinary(bad); err != ErrEncodingVersion {
+		t.Error("Expected ErrEncodingVersion, got", err)
+	}
+
+	bad = append([]byte{}, b...)
+	bad[0] ^= 2
+	if err := k.UnmarshalBinary(bad); err != ErrParams {
+		t.Error("Expected ErrParams, got", err)
+	}
+
+	txt, _ := RandomKeyset(3)[0].MarshalText()
+	bad = bytes.Replace(txt, []byte("Params: "), []byte("Params: 0"), 1)
+	if err := k.UnmarshalText(bad); err != ErrParams {
+		t.Error("Expected ErrParams, got", err)
+	}
+
+	// the Params header is required
+	lines := strings.Split(string(txt), "\n")
+	bad = []byte(strings.Join(append(lines[:1:1], lines[3:]...), "\n"))
+	if err := k.UnmarshalText(bad); err != ErrParams {
+		t.Error("Expected ErrParams for a missing header, got", err)
+	}
+}
+
+func TestParamsKeysetEncoding(t *testing.T) {
+	pr := DefaultParams()
+	pr.Seeds[0]++
+	ks := RandomKeyset(3)
+
+	b, err := pr.EncodeKeyset(ks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := pr.DecodeKeyset(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range ks {
+		if got[i].Invert != ks[i].Invert || !bytes.Equal(got[i].Data, ks[i].Data) {
+			t.Error("Key", i, "did not round trip")
+		}
+	}
+	if pr.tag() != defaultParams.tag() {
+		var def Keyset
+		if err := def.UnmarshalBinary(b); err != ErrParams {
+			t.Error("Expected ErrParams, got", err)
+		}
+	}
+
+	txt, err := pr.EncodeKeysetText(ks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err = pr.DecodeKeysetText(txt); err != nil || len(got) != len(ks) {
+		t.Error("Keyset text did not round trip", err)
+	}
+	var def Keyset
+	if err := def.UnmarshalText(txt); err != ErrParams {
+		t.Error("Expected ErrParams, got", err)
+	}
+
+	kt, err := pr.EncodeKeyText(ks[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k, err := pr.DecodeKeyText(kt); err != nil || !bytes.Equal(k.Data, ks[0].Data) {
+		t.Error("Key text did not round trip", err)
+	}
+}
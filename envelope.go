@@ -0,0 +1,90 @@
+package cyclicKey
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"hash"
+)
+
+// ErrTampered is returned by Open when the envelope does not check out: either
+// a key in the cycle was missed or applied twice, or a hop changed the message.
+var ErrTampered = errors.New("cyclicKey: cycle incomplete or tampered")
+
+// TagSize is the number of bytes Seal adds to a payload.
+const TagSize = sha256.Size
+
+// Each byte of a message is ciphered on its own by a multiplication mod p, so a
+// hop can multiply any byte of the state by a value of its choosing and the
+// change comes out of the cycle intact. An envelope adds an HMAC-SHA256 tag to
+// the plaintext, and the tag goes through the cycle with the payload. It only
+// reads back once every key has been applied, so it is checked after the
+// recipient's key has closed the cycle.
+//
+// The tag is keyed from the recipient's key, which the sender knows and the
+// hops do not, so no secret beyond the keyset is needed. The nonce of the
+// parameter set is covered by the tag as well.
+
+// Seal returns payload in an envelope for recipient, under the default
+// parameters. The envelope is plaintext: it is ciphered by every key of the
+// cycle but recipient's and then opened by the recipient with Open.
+func Seal(payload []byte, recipient Key) []byte {
+	return defaultParams.Seal(payload, recipient)
+}
+
+// Open applies recipient's key to a state sealed with Seal, checks the tag and
+// returns the payload. It returns ErrTampered if the cycle did not close or the
+// message was changed along the way.
+func Open(state []byte, recipient Key) ([]byte, error) {
+	return defaultParams.Open(state, recipient)
+}
+
+// Seal returns payload in an envelope for recipient under the parameter set,
+// see Seal.
+func (pr *Params) Seal(payload []byte, recipient Key) []byte {
+	env := make([]byte, len(payload), len(payload)+TagSize)
+	copy(env, payload)
+	return append(env, pr.envelopeTag(payload, recipient)...)
+}
+
+// Open opens an envelope under the parameter set, see Open.
+func (pr *Params) Open(state []byte, recipient Key) ([]byte, error) {
+	if len(state) < TagSize {
+		return nil, ErrTampered
+	}
+	env := pr.Cipher(state, recipient.Data, recipient.Invert)
+	payload, tag := env[:len(env)-TagSize], env[len(env)-TagSize:]
+	if !hmac.Equal(tag, pr.envelopeTag(payload, recipient)) {
+		return nil, ErrTampered
+	}
+	return payload[:len(payload):len(payload)], nil
+}
+
+// envelopeTag computes the tag for payload.
+func (pr *Params) envelopeTag(payload []byte, recipient Key) []byte {
+	mac := pr.recipientMAC(recipient, "envelope")
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// recipientMAC returns an HMAC-SHA256 keyed from a hash of the recipient's key
+// and inversion flag, with the purpose folded into the key so that the values
+// for one purpose say nothing about another. The nonce has already been
+// written, with a length prefix so that it can't run into what follows.
+func (pr *Params) recipientMAC(recipient Key, purpose string) hash.Hash {
+	kh := sha256.New()
+	kh.Write([]byte("cyclicKey " + purpose))
+	kh.Write(recipient.Data)
+	if recipient.Invert {
+		kh.Write([]byte{1})
+	} else {
+		kh.Write([]byte{0})
+	}
+	mac := hmac.New(sha256.New, kh.Sum(nil))
+	var n [8]byte
+	binary.LittleEndian.PutUint64(n[:], uint64(len(pr.Nonce)))
+	mac.Write(n[:])
+	mac.Write(pr.Nonce)
+	return mac
+}
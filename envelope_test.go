@@ -0,0 +1,82 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestEnvelope(t *testing.T) {
+	payload := make([]byte, 500)
+	rand.Read(payload)
+	keys := RandomKeyset(4)
+	recipient, hops := keys[3], keys[:3]
+
+	env := Seal(payload, recipient)
+	if len(env) != len(payload)+TagSize {
+		t.Error("Wrong envelope length", len(env))
+	}
+	state := CipherMany(env, hops)
+	got, err := Open(state, recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(payload, got) {
+		t.Error("Opened payload does not match")
+	}
+
+	// a hop multiplies one byte of the state
+	tampered := append([]byte(nil), state...)
+	tampered[10] = byte((uint32(tampered[10])+1)*2%p - 1)
+	if _, err := Open(tampered, recipient); err != ErrTampered {
+		t.Error("Expected ErrTampered for a changed byte, got", err)
+	}
+
+	// a hop is skipped
+	if _, err := Open(CipherMany(env, hops[:2]), recipient); err != ErrTampered {
+		t.Error("Expected ErrTampered for a missing key, got", err)
+	}
+
+	// a hop is applied twice
+	if _, err := Open(hops[0].Apply(state), recipient); err != ErrTampered {
+		t.Error("Expected ErrTampered for a repeated key, got", err)
+	}
+
+	// a different key can't open it
+	if _, err := Open(state, hops[0]); err != ErrTampered {
+		t.Error("Expected ErrTampered for the wrong recipient, got", err)
+	}
+
+	if _, err := Open(state[:TagSize-1], recipient); err != ErrTampered {
+		t.Error("Expected ErrTampered for a short state, got", err)
+	}
+}
+
+func TestEnvelopeNonce(t *testing.T) {
+	payload := []byte("the nonce is covered by the tag")
+	pr := DefaultParams()
+	keys := pr.RandomKeyset(3)
+	recipient, hops := keys[0], keys[1:]
+
+	pr.Nonce = []byte("nonce")
+	state := pr.CipherMany(pr.Seal(payload, recipient), hops)
+	got, err := pr.Open(state, recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(payload, got) {
+		t.Error("Opened payload does not match")
+	}
+
+	pr.Nonce = []byte("other")
+	if _, err := pr.Open(state, recipient); err != ErrTampered {
+		t.Error("Expected ErrTampered for the wrong nonce, got", err)
+	}
+
+	// an empty payload still carries a tag
+	pr.Nonce = nil
+	got, err = pr.Open(pr.CipherMany(pr.Seal(nil, recipient), hops), recipient)
+	if err != nil || len(got) != 0 {
+		t.Error("Empty payload did not open:", got, err)
+	}
+}
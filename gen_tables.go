@@ -0,0 +1,129 @@
+//go:build ignore
+
+// gen_tables writes tables.go, the modulus inversion and power modulus tables
+// used by Group257. Run it with go generate, or directly to build tables for
+// another parameter set:
+//
+//	go run gen_tables.go -p 257 -r 3 -o tables.go
+//
+// The prime must be small enough that each inverse fits in a byte. The rows of
+// the power modulus table are the primitive roots lpr^e for every exponent e
+// coprime to p-1, in ascending order of e.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strconv"
+)
+
+// lineWidth is the column the number lists are wrapped at, with a tab counted
+// as one column.
+const lineWidth = 79
+
+func main() {
+	p := flag.Uint("p", 257, "prime")
+	r := flag.Uint("r", 3, "primitive root of the prime")
+	out := flag.String("o", "tables.go", "output file")
+	flag.Parse()
+
+	src, err := generate(uint64(*p), uint64(*r))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(p, r uint64) ([]byte, error) {
+	if p < 3 || p > 257 {
+		return nil, fmt.Errorf("gen_tables: prime %d does not fit the byte inversion table", p)
+	}
+	s := p - 1
+	if order(r, p) != s {
+		return nil, fmt.Errorf("gen_tables: %d is not a primitive root of %d", r, p)
+	}
+
+	inv := make([]uint64, p)
+	for x := uint64(1); x < p; x++ {
+		// x^(p-2) is the inverse of x by Fermat's little theorem
+		inv[x-1] = powMod(x, p-2, p) - 1
+	}
+
+	var pm []uint64
+	for e := uint64(1); e < s; e++ {
+		if gcd(e, s) != 1 {
+			continue
+		}
+		base := powMod(r, e, p)
+		for x := uint64(0); x < p; x++ {
+			pm = append(pm, powMod(base, x, p))
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_tables.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package cyclicKey\n\n")
+	buf.WriteString("// modulus inversion table\n")
+	writeList(&buf, "invTbl", "byte", inv)
+	buf.WriteString("\n// power modulus table\n")
+	writeList(&buf, "pmTbl", "uint32", pm)
+	return format.Source(buf.Bytes())
+}
+
+// writeList writes the values as an array literal, filling each line up to
+// lineWidth.
+func writeList(buf *bytes.Buffer, name, typ string, vals []uint64) {
+	fmt.Fprintf(buf, "var %s = [...]%s{\n", name, typ)
+	line := "\t"
+	for i, v := range vals {
+		item := strconv.FormatUint(v, 10) + ","
+		if len(line) > 1 && len(line)+1+len(item) > lineWidth {
+			buf.WriteString(line + "\n")
+			line = "\t"
+		}
+		if len(line) > 1 {
+			line += " "
+		}
+		line += item
+		if i == len(vals)-1 {
+			buf.WriteString(line + "\n")
+		}
+	}
+	buf.WriteString("}\n")
+}
+
+func order(r, p uint64) uint64 {
+	x, n := r%p, uint64(1)
+	for x != 1 {
+		if x == 0 {
+			return 0
+		}
+		x, n = x*r%p, n+1
+	}
+	return n
+}
+
+func powMod(b, e, m uint64) uint64 {
+	r := uint64(1)
+	b %= m
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = r * b % m
+		}
+		b = b * b % m
+	}
+	return r
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,342 @@
+package cyclicKey
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// Errors returned when building a group or converting symbols
+var (
+	ErrPrime   = errors.New("cyclicKey: group needs an odd prime no larger than 2^20")
+	ErrSymbols = errors.New("cyclicKey: symbols do not encode a byte stream")
+)
+
+// maxPrime bounds the size of the exp and log tables built for a group.
+const maxPrime = 1 << 20
+
+// Group is a prime along with the tables the cipher uses to work modulo it.
+// Symbols are values in [0, p-2], a key is a sequence of exponents mod p-1.
+//
+// Group257 uses the precomputed power mod tables. Every other group works on
+// exponents instead: the key product is lpr raised to the sum of the key
+// exponents times the root exponents, and applying it is an addition in the
+// exponent using the log and exp tables.
+type Group struct {
+	p, lpr, s uint32
+	// roots is the number of primitive roots the root queue cycles through
+	roots uint32
+	// rootExp[ri] is the exponent of the primitive root at index ri,
+	// r = lpr^rootExp[ri]. These are the exponents coprime to s, ascending.
+	rootExp []uint32
+	// exp[e] = lpr^e % p for e in [0, s), log is its inverse for x in [1, p)
+	exp []uint32
+	log []uint32
+	// width is the number of bytes a key symbol is stored in
+	width int
+	// digits[l] is the number of symbols that hold l bytes
+	digits [9]int
+	// stride is what a root index is multiplied by in the root queue: p for
+	// the power mod table, 1 when working on exponents
+	stride uint32
+	// pm is the power mod table, pm[ri*p + e] = (r^e) % p where r is the
+	// primitive root at index ri
+	pm []uint32
+	// inv is the modulus inversion table, inv[x-1] = (x^-1 % p) - 1
+	inv []byte
+}
+
+// Group257 is the prime 257 with the primitive root 3, the group the package
+// was built around. Each symbol is one byte.
+var Group257 = newTableGroup()
+
+func newTableGroup() *Group {
+	g, err := newGroup(p)
+	if err != nil {
+		panic(err)
+	}
+	g.stride, g.pm, g.inv = p, pmTbl[:], invTbl[:]
+	return g
+}
+
+//go:generate go run gen_tables.go -p 257 -r 3 -o tables.go
+
+// VerifyTables checks every entry of the precomputed tables Group257 uses
+// against the prime and primitive root they were generated from: each inverse,
+// each power, and that the base of each row of the power mod table is a
+// generator of the group.
+func VerifyTables() error {
+	return verifyTables(Group257, pmTbl[:], invTbl[:])
+}
+
+func verifyTables(g *Group, pm []uint32, inv []byte) error {
+	p := uint64(g.p)
+	if len(inv) != int(p) {
+		return fmt.Errorf("cyclicKey: invTbl has %d entries, expected %d", len(inv), p)
+	}
+	for x := uint64(1); x < p; x++ {
+		if x*(uint64(inv[x-1])+1)%p != 1 {
+			return fmt.Errorf("cyclicKey: invTbl[%d] = %d is not the inverse of %d", x-1, inv[x-1], x)
+		}
+	}
+	if inv[p-1] != 0 {
+		return fmt.Errorf("cyclicKey: invTbl[%d] = %d, expected 0", p-1, inv[p-1])
+	}
+
+	if len(pm) != int(g.roots)*int(p) {
+		return fmt.Errorf("cyclicKey: pmTbl has %d entries, expected %d", len(pm), int(g.roots)*int(p))
+	}
+	seen := make([]bool, p)
+	for ri := uint32(0); ri < g.roots; ri++ {
+		row := pm[uint64(ri)*p : uint64(ri+1)*p]
+		base := uint64(g.exp[g.rootExp[ri]])
+		if uint64(row[1]) != base {
+			return fmt.Errorf("cyclicKey: pmTbl row %d has base %d, expected %d", ri, row[1], base)
+		}
+		for i := range seen {
+			seen[i] = false
+		}
+		x := uint64(1)
+		for e := range row {
+			if uint64(row[e]) != x {
+				return fmt.Errorf("cyclicKey: pmTbl[%d] = %d, expected %d", uint64(ri)*p+uint64(e), row[e], x)
+			}
+			// a generator reaches every element once before returning to 1
+			if e < int(g.s) {
+				if seen[x] {
+					return fmt.Errorf("cyclicKey: pmTbl row %d base %d is not a generator", ri, base)
+				}
+				seen[x] = true
+			}
+			x = x * base % p
+		}
+	}
+	return nil
+}
+
+// NewGroup builds a group for the prime p, for instance 65537 for 16 bit
+// symbols or a small prime for a compact alphabet. The tables are built at
+// runtime and take about 8 bytes per element of the group. NewGroup(257)
+// returns Group257.
+func NewGroup(p uint32) (*Group, error) {
+	if p == Group257.p {
+		return Group257, nil
+	}
+	return newGroup(p)
+}
+
+func newGroup(p uint32) (*Group, error) {
+	if p < 3 || p > maxPrime || !big.NewInt(int64(p)).ProbablyPrime(0) {
+		return nil, ErrPrime
+	}
+	g := &Group{
+		p:      p,
+		s:      p - 1,
+		stride: 1,
+		width:  (bits.Len32(p-2) + 7) / 8,
+	}
+	g.lpr = primitiveRoot(p)
+
+	g.exp = make([]uint32, g.s)
+	g.log = make([]uint32, p)
+	x := uint64(1)
+	for e := uint32(0); e < g.s; e++ {
+		g.exp[e] = uint32(x)
+		g.log[x] = e
+		x = x * uint64(g.lpr) % uint64(p)
+	}
+	for e := uint32(1); e < g.s; e++ {
+		if gcd(e, g.s) == 1 {
+			g.rootExp = append(g.rootExp, e)
+		}
+	}
+	g.roots = uint32(len(g.rootExp))
+
+	for l := 1; l <= 8; l++ {
+		g.digits[l] = digitsFor(uint64(g.s), l)
+	}
+	return g, nil
+}
+
+// primitiveRoot returns the smallest primitive root of the prime p. g is a
+// primitive root when g^(s/q) != 1 for every prime factor q of s.
+func primitiveRoot(p uint32) uint32 {
+	s := p - 1
+	var factors []uint32
+	n := s
+	for q := uint32(2); q*q <= n; q++ {
+		if n%q == 0 {
+			factors = append(factors, q)
+			for n%q == 0 {
+				n /= q
+			}
+		}
+	}
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	for r := uint32(2); ; r++ {
+		primitive := true
+		for _, q := range factors {
+			if powMod(r, s/q, p) == 1 {
+				primitive = false
+				break
+			}
+		}
+		if primitive {
+			return r
+		}
+	}
+}
+
+func powMod(b, e, m uint32) uint32 {
+	r, x := uint64(1), uint64(b)%uint64(m)
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = r * x % uint64(m)
+		}
+		x = x * x % uint64(m)
+	}
+	return uint32(r)
+}
+
+func gcd(a, b uint32) uint32 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// digitsFor returns the number of base s digits needed to hold any value of l
+// bytes, the smallest d with s^d >= 2^(8l).
+func digitsFor(s uint64, l int) int {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(8*l))
+	bs := new(big.Int).SetUint64(s)
+	d := 0
+	for c := big.NewInt(1); c.Cmp(limit) < 0; c.Mul(c, bs) {
+		d++
+	}
+	return d
+}
+
+// P returns the prime of the group.
+func (g *Group) P() uint32 {
+	return g.p
+}
+
+// Generator returns the primitive root the group's tables are built on.
+func (g *Group) Generator() uint32 {
+	return g.lpr
+}
+
+// KeyWidth returns the number of bytes each key symbol is stored in.
+func (g *Group) KeyWidth() int {
+	return g.width
+}
+
+// Log returns the discrete log of x to the base Generator, for x in [1, p-1].
+func (g *Group) Log(x uint32) uint32 {
+	return g.log[x]
+}
+
+// Exp returns Generator raised to e, for e in [0, p-2].
+func (g *Group) Exp(e uint32) uint32 {
+	return g.exp[e]
+}
+
+// keyLen returns the number of symbols in key. It panics if the key is not a
+// whole number of symbols, rather than dropping the bytes left over.
+func (g *Group) keyLen(key []byte) int {
+	if len(key)%g.width != 0 {
+		panic("cyclicKey: key length is not a multiple of the symbol width")
+	}
+	return len(key) / g.width
+}
+
+// symbol returns key symbol j, stored little endian in width bytes.
+func (g *Group) symbol(key []byte, j int) uint32 {
+	if g.width == 1 {
+		return uint32(key[j])
+	}
+	v := uint32(0)
+	for b := g.width - 1; b >= 0; b-- {
+		v = v<<8 | uint32(key[j*g.width+b])
+	}
+	return v
+}
+
+// k32 returns the exponent key symbol j contributes under the rotation value
+// xs4.
+func (g *Group) k32(key []byte, j int, xs4 uint32) uint32 {
+	s := uint64(g.s)
+	return uint32((uint64(g.symbol(key, j)) + 1) * (uint64(xs4)%s + 1) % s)
+}
+
+// SymbolLen returns the number of symbols ToSymbols produces for n bytes.
+func (g *Group) SymbolLen(n int) int {
+	return (n/8)*g.digits[8] + g.digits[n%8]
+}
+
+// ToSymbols converts a byte stream to a stream of base p-1 symbols that can be
+// ciphered with Params.CipherSymbols. Every 8 bytes become a fixed number of
+// symbols, so the conversion can be undone with FromSymbols once the cycle has
+// closed. For Group257 the symbols are the bytes.
+func (g *Group) ToSymbols(data []byte) []uint32 {
+	out := make([]uint32, 0, g.SymbolLen(len(data)))
+	var block [8]byte
+	s := uint64(g.s)
+	for len(data) > 0 {
+		l := len(data)
+		if l > 8 {
+			l = 8
+		}
+		block = [8]byte{}
+		copy(block[:], data[:l])
+		v := binary.LittleEndian.Uint64(block[:])
+		for d := 0; d < g.digits[l]; d++ {
+			out = append(out, uint32(v%s))
+			v /= s
+		}
+		data = data[l:]
+	}
+	return out
+}
+
+// FromSymbols converts symbols written by ToSymbols back to n bytes. It returns
+// ErrSymbols if the symbols are not a valid encoding, which is what happens
+// when they are converted before the cycle has closed.
+func (g *Group) FromSymbols(symbols []uint32, n int) ([]byte, error) {
+	if n < 0 || len(symbols) != g.SymbolLen(n) {
+		return nil, ErrSymbols
+	}
+	out := make([]byte, 0, n+8)
+	var block [8]byte
+	s := uint64(g.s)
+	for n > 0 {
+		l := n
+		if l > 8 {
+			l = 8
+		}
+		d := g.digits[l]
+		v := uint64(0)
+		for i := d - 1; i >= 0; i-- {
+			sym := uint64(symbols[i])
+			hi, lo := bits.Mul64(v, s)
+			lo, carry := bits.Add64(lo, sym, 0)
+			if sym >= s || hi != 0 || carry != 0 {
+				return nil, ErrSymbols
+			}
+			v = lo
+		}
+		if l < 8 && v>>(8*uint(l)) != 0 {
+			return nil, ErrSymbols
+		}
+		binary.LittleEndian.PutUint64(block[:], v)
+		out = append(out, block[:l]...)
+		symbols = symbols[d:]
+		n -= l
+	}
+	return out, nil
+}
@@ -0,0 +1,213 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	mrand "math/rand"
+	"testing"
+)
+
+func TestGroup257(t *testing.T) {
+	if Group257.lpr != lpr || Group257.roots != 128 {
+		t.Error("Group257 does not match the tables", Group257.lpr, Group257.roots)
+	}
+	// the root exponents are the rows of pmTbl
+	for ri, e := range Group257.rootExp {
+		if pmTbl[ri*257+1] != Group257.exp[e] {
+			t.Error("Root exponent does not match pmTbl at", ri)
+		}
+	}
+	g, err := NewGroup(257)
+	if err != nil || g != Group257 {
+		t.Error("NewGroup(257) should return Group257")
+	}
+}
+
+func TestVerifyTables(t *testing.T) {
+	if err := VerifyTables(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a single corrupted entry anywhere should be caught
+	for _, i := range []int{0, 1, 256, 257 * 64, len(pmTbl) - 1} {
+		pm := append([]uint32(nil), pmTbl[:]...)
+		pm[i] = pm[i]%256 + 1
+		if verifyTables(Group257, pm, invTbl[:]) == nil {
+			t.Error("Did not catch a bad pmTbl entry at", i)
+		}
+	}
+	for _, i := range []int{0, 100, 255} {
+		inv := append([]byte(nil), invTbl[:]...)
+		inv[i]++
+		if verifyTables(Group257, pmTbl[:], inv) == nil {
+			t.Error("Did not catch a bad invTbl entry at", i)
+		}
+	}
+}
+
+func TestNewGroupErrors(t *testing.T) {
+	for _, p := range []uint32{0, 1, 2, 4, 255, 65535, 1<<20 + 7} {
+		if _, err := NewGroup(p); err != ErrPrime {
+			t.Error("Expected ErrPrime for", p, "got", err)
+		}
+	}
+}
+
+// The exponent path must give exactly the same output as the power mod tables.
+func TestExponentPathMatchesTables(t *testing.T) {
+	g, err := newGroup(257)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables := DefaultParams()
+	exps := tables
+	exps.Group = g
+
+	m := make([]byte, 2000)
+	rand.Read(m)
+	for _, kl := range []int{1, 10, 130} {
+		key := randomKey(kl, kl == 10)
+		expected := tables.Cipher(m, key.Data, key.Invert)
+		got, err := g.FromSymbols(exps.ApplySymbols(g.ToSymbols(m), key), len(m))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, got) {
+			t.Error("Exponent path does not match tables for key length", kl)
+		}
+	}
+}
+
+func TestOtherPrimes(t *testing.T) {
+	m := make([]byte, 1001)
+	rand.Read(m)
+	for _, p := range []uint32{3, 5, 17, 251, 65537} {
+		g, err := NewGroup(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pr := DefaultParams()
+		pr.Group = g
+		keys, err := pr.NewKeyset(4, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys[0].Data) != pr.KeyLength*g.KeyWidth() {
+			t.Error("Wrong key length for", p)
+		}
+
+		sym := g.ToSymbols(m)
+		c := sym
+		for n, i := range mrand.Perm(len(keys)) {
+			c = pr.ApplySymbols(c, keys[i])
+			if n == 0 && p > 17 {
+				// part way through the cycle the symbols will rarely
+				// decode, but they must never decode to the message. Tiny
+				// groups have too few keys for this to hold.
+				if got, err := g.FromSymbols(c, len(m)); err == nil && bytes.Equal(got, m) {
+					t.Error("Partial cycle returned the message for", p)
+				}
+			}
+		}
+		got, err := g.FromSymbols(c, len(m))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(m, got) {
+			t.Error("Did not cycle for", p)
+		}
+
+		// key algebra follows the group
+		a, b := keys[0], keys[1]
+		if !equalSymbols(pr.ApplySymbols(pr.ApplySymbols(sym, a), b), pr.ApplySymbols(sym, pr.Combine(a, b))) {
+			t.Error("Combine does not match for", p)
+		}
+	}
+}
+
+func equalSymbols(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSymbolsRoundTrip(t *testing.T) {
+	for _, p := range []uint32{3, 11, 17, 257, 65537} {
+		g, err := NewGroup(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for n := 0; n < 20; n++ {
+			m := make([]byte, n)
+			rand.Read(m)
+			sym := g.ToSymbols(m)
+			if len(sym) != g.SymbolLen(n) {
+				t.Error("Wrong symbol count for", p, n)
+			}
+			for _, v := range sym {
+				if v >= p-1 {
+					t.Error("Symbol out of range for", p)
+				}
+			}
+			got, err := g.FromSymbols(sym, n)
+			if err != nil || !bytes.Equal(m, got) {
+				t.Error("Did not round trip for", p, n, err)
+			}
+		}
+	}
+
+	g, _ := NewGroup(11)
+	sym := g.ToSymbols([]byte{255})
+	for i := range sym {
+		sym[i] = 9
+	}
+	if _, err := g.FromSymbols(sym, 1); err != ErrSymbols {
+		t.Error("Expected ErrSymbols, got", err)
+	}
+}
+
+func TestByteAPIRejectsOtherGroups(t *testing.T) {
+	g, _ := NewGroup(17)
+	pr := DefaultParams()
+	pr.Group = g
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic")
+		}
+	}()
+	pr.Cipher([]byte{1, 2, 3}, []byte{1, 2}, false)
+}
+
+func TestKeyWidth(t *testing.T) {
+	g, err := NewGroup(65537)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr := DefaultParams()
+	pr.Group = g
+
+	// a key of 2 byte symbols with a byte left over
+	b, err := pr.EncodeKey(Key{Data: []byte{1, 2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pr.DecodeKey(b); err != ErrKeyEncoding {
+		t.Error("Expected ErrKeyEncoding, got", err)
+	}
+	if _, err := pr.GenerateKeysetFor(Key{Data: []byte{1, 2, 3}}, 2, nil); err != KeyLengthError(3) {
+		t.Error("Expected KeyLengthError, got", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for a partial symbol")
+		}
+	}()
+	pr.CipherSymbols([]uint32{1, 2, 3}, []byte{1, 2, 3}, false)
+}
@@ -0,0 +1,198 @@
+// Package linmod solves systems of linear equations modulo 2^k. For Group257
+// the exponents of the cipher live in Z/256, where only odd numbers can be
+// inverted, so ordinary Gaussian elimination can't be used.
+//
+// The equations are kept in Howell form: at most one row per column, each
+// with a power of two as its pivot and zeros before it. Whenever a row with
+// pivot 2^v is added, the row times 2^(k-v), which clears the pivot, is added
+// as well. That makes every combination of the rows reducible by the rows
+// after it, so the system is consistent exactly when no row reduces to 0 = y
+// with y non-zero, and each column with pivot 2^v leaves 2^v choices.
+package linmod
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// ErrInconsistent is returned when an equation contradicts the ones already
+// added.
+var ErrInconsistent = errors.New("linmod: inconsistent system")
+
+// System is a set of linear equations in n unknowns modulo 2^k. Equations can
+// be added one at a time; the system never holds more than n rows.
+type System struct {
+	n    int
+	k    uint
+	mask uint64
+	// rows[c] is the row with its pivot in column c, or nil. A row is n
+	// coefficients followed by the right hand side.
+	rows [][]uint64
+	err  error
+}
+
+// New returns an empty system in n unknowns modulo 2^k, for k in [1, 32].
+func New(n int, k uint) *System {
+	if k < 1 || k > 32 {
+		panic("linmod: modulus must be 2^k for k in [1, 32]")
+	}
+	return &System{
+		n:    n,
+		k:    k,
+		mask: 1<<k - 1,
+		rows: make([][]uint64, n),
+	}
+}
+
+// Add adds the equation sum(coeffs[j] * x[j]) = y. If the equation contradicts
+// the system it returns ErrInconsistent, and so does every later call; the
+// system can't be used after that.
+func (sys *System) Add(coeffs []uint32, y uint32) error {
+	if sys.err != nil {
+		return sys.err
+	}
+	r := make([]uint64, sys.n+1)
+	for j, c := range coeffs {
+		r[j] = uint64(c) & sys.mask
+	}
+	r[sys.n] = uint64(y) & sys.mask
+	if !sys.insert(r) {
+		sys.err = ErrInconsistent
+	}
+	return sys.err
+}
+
+// Err returns ErrInconsistent if an inconsistent equation has been added.
+func (sys *System) Err() error {
+	return sys.err
+}
+
+// insert reduces r by the rows of the system and keeps what is left. It
+// returns false if r reduces to 0 = y with y non-zero.
+func (sys *System) insert(r []uint64) bool {
+	for c := 0; c < sys.n; c++ {
+		if r[c] == 0 {
+			continue
+		}
+		v := uint(bits.TrailingZeros64(r[c]))
+		b := sys.rows[c]
+		if b != nil {
+			bv := uint(bits.TrailingZeros64(b[c]))
+			if v >= bv {
+				sys.sub(r, b, r[c]>>bv)
+				continue
+			}
+		}
+		// r has the smaller pivot, so it takes the column and the row it
+		// replaces is reduced by it
+		sys.scale(r, inverse(r[c]>>v))
+		sys.rows[c] = r
+		if v > 0 {
+			t := append([]uint64(nil), r...)
+			sys.scale(t, 1<<(sys.k-v))
+			if !sys.insert(t) {
+				return false
+			}
+		}
+		if b != nil {
+			return sys.insert(b)
+		}
+		return true
+	}
+	return r[sys.n] == 0
+}
+
+// sub sets r to r - q*b.
+func (sys *System) sub(r, b []uint64, q uint64) {
+	for j := range r {
+		r[j] = (r[j] - q*b[j]) & sys.mask
+	}
+}
+
+func (sys *System) scale(r []uint64, q uint64) {
+	for j := range r {
+		r[j] = r[j] * q & sys.mask
+	}
+}
+
+// inverse returns the inverse of the odd number u modulo 2^64, which is also
+// its inverse modulo every smaller power of two. Each Newton step doubles the
+// number of correct bits.
+func inverse(u uint64) uint64 {
+	x := u
+	for i := 0; i < 5; i++ {
+		x *= 2 - u*x
+	}
+	return x
+}
+
+// Pivot returns the pivot of column c, 2^v, as v. A column without a pivot
+// returns k.
+func (sys *System) Pivot(c int) uint {
+	if sys.rows[c] == nil {
+		return sys.k
+	}
+	return uint(bits.TrailingZeros64(sys.rows[c][c]))
+}
+
+// SolutionBits returns the base 2 log of the number of solutions. Each column
+// with pivot 2^v is fixed modulo 2^(k-v), leaving 2^v choices, and a column
+// without a pivot can be anything. It returns -1 for an inconsistent system.
+func (sys *System) SolutionBits() int {
+	if sys.err != nil {
+		return -1
+	}
+	n := 0
+	for c := 0; c < sys.n; c++ {
+		n += int(sys.Pivot(c))
+	}
+	return n
+}
+
+// Solutions returns up to max solutions, fewer if the system has fewer.
+func (sys *System) Solutions(max int) [][]uint32 {
+	if sys.err != nil || max <= 0 {
+		return nil
+	}
+	var out [][]uint32
+	x := make([]uint64, sys.n)
+	var solve func(c int) bool
+	solve = func(c int) bool {
+		if c < 0 {
+			s := make([]uint32, sys.n)
+			for j, v := range x {
+				s[j] = uint32(v)
+			}
+			out = append(out, s)
+			return len(out) < max
+		}
+		b := sys.rows[c]
+		if b == nil {
+			for v := uint64(0); v <= sys.mask; v++ {
+				x[c] = v
+				if !solve(c - 1) {
+					return false
+				}
+			}
+			return true
+		}
+		rhs := b[sys.n]
+		for j := c + 1; j < sys.n; j++ {
+			rhs -= b[j] * x[j]
+		}
+		rhs &= sys.mask
+		v := uint(bits.TrailingZeros64(b[c]))
+		if rhs&(1<<v-1) != 0 {
+			return true
+		}
+		for t := uint64(0); t < 1<<v; t++ {
+			x[c] = (rhs>>v + t<<(sys.k-v)) & sys.mask
+			if !solve(c - 1) {
+				return false
+			}
+		}
+		return true
+	}
+	solve(sys.n - 1)
+	return out
+}
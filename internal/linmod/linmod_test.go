@@ -0,0 +1,100 @@
+package linmod
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func eval(coeffs []uint32, x []uint32, mask uint64) uint32 {
+	e := uint64(0)
+	for j, c := range coeffs {
+		e += uint64(c) * uint64(x[j])
+	}
+	return uint32(e & mask)
+}
+
+func TestBruteForce(t *testing.T) {
+	// every solution of a tiny system, counted by trying every x
+	const k, n = 3, 3
+	mask := uint64(1<<k - 1)
+	for trial := 0; trial < 300; trial++ {
+		x := []uint32{uint32(rand.Intn(8)), uint32(rand.Intn(8)), uint32(rand.Intn(8))}
+		sys := New(n, k)
+		var eqs [][]uint32
+		for e := 0; e < rand.Intn(5); e++ {
+			c := []uint32{uint32(rand.Intn(8)), uint32(rand.Intn(8)), uint32(rand.Intn(8))}
+			eqs = append(eqs, c)
+			if err := sys.Add(c, eval(c, x, mask)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		count := 0
+		for a := uint32(0); a < 8*8*8; a++ {
+			y := []uint32{a & 7, a >> 3 & 7, a >> 6}
+			ok := true
+			for _, c := range eqs {
+				if eval(c, y, mask) != eval(c, x, mask) {
+					ok = false
+				}
+			}
+			if ok {
+				count++
+			}
+		}
+		if 1<<uint(sys.SolutionBits()) != count {
+			t.Fatal("Expected", count, "solutions, got 2^", sys.SolutionBits(), eqs)
+		}
+		sols := sys.Solutions(1000)
+		if len(sols) != count {
+			t.Fatal("Expected", count, "solutions, enumerated", len(sols))
+		}
+		for _, s := range sols {
+			for _, c := range eqs {
+				if eval(c, s, mask) != eval(c, x, mask) {
+					t.Fatal("Bad solution", s)
+				}
+			}
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	const n = 20
+	x := make([]uint32, n)
+	for j := range x {
+		x[j] = uint32(rand.Intn(256))
+	}
+	sys := New(n, 8)
+	for i := 0; i < 4*n && sys.SolutionBits() > 0; i++ {
+		c := make([]uint32, n)
+		for j := range c {
+			c[j] = uint32(rand.Intn(256))
+		}
+		if err := sys.Add(c, eval(c, x, 255)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sols := sys.Solutions(2)
+	if len(sols) != 1 {
+		t.Fatal("Expected a unique solution, got", len(sols))
+	}
+	for j := range x {
+		if sols[0][j] != x[j] {
+			t.Fatal("Wrong solution")
+		}
+	}
+}
+
+func TestInconsistent(t *testing.T) {
+	sys := New(2, 8)
+	if err := sys.Add([]uint32{2, 0}, 4); err != nil {
+		t.Fatal(err)
+	}
+	// 2x = 4 allows x = 2 or 130, 4x = 9 is odd so has no solution
+	if err := sys.Add([]uint32{4, 0}, 9); err != ErrInconsistent {
+		t.Error("Expected ErrInconsistent, got", err)
+	}
+	if sys.SolutionBits() != -1 || sys.Solutions(1) != nil {
+		t.Error("Inconsistent system has solutions")
+	}
+}
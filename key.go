@@ -0,0 +1,182 @@
+package cyclicKey
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+// Key is a single key from a cyclic keyset along with its inversion flag.
+//
+// The inversion flag is chosen at random for every key in a set, including the
+// compound key, so the flag gives no indication of which key closes the cycle.
+type Key struct {
+	Data   []byte
+	Invert bool
+}
+
+// Apply ciphers msg with the key under the default parameters. Because the key
+// carries its own inversion flag, the caller never needs to know where in the
+// cycle the key belongs.
+func (k Key) Apply(msg []byte) []byte {
+	return Cipher(msg, k.Data, k.Invert)
+}
+
+// Keyset is a cyclic set of keys. Applying every key in the set, in any order,
+// returns the original message.
+type Keyset []Key
+
+// KeyCountError is returned when a keyset is requested with fewer than 3 keys.
+type KeyCountError int
+
+func (e KeyCountError) Error() string {
+	return fmt.Sprintf("cyclicKey: a keyset needs at least 3 keys, got %d", int(e))
+}
+
+// KeyLengthError is returned when a key length is not supported.
+type KeyLengthError int
+
+func (e KeyLengthError) Error() string {
+	return fmt.Sprintf("cyclicKey: unsupported key length %d", int(e))
+}
+
+// EntropyError is returned when the source of randomness fails.
+type EntropyError struct {
+	Err error
+}
+
+func (e *EntropyError) Error() string {
+	return "cyclicKey: reading randomness: " + e.Err.Error()
+}
+
+// Unwrap returns the error from the source of randomness.
+func (e *EntropyError) Unwrap() error {
+	return e.Err
+}
+
+// randomKeys reads n random keys of length kl from rnd. If flags is true each
+// key gets a random inversion flag, otherwise none are inverted. All of the key
+// bytes are read first, followed by one byte per key for the flags.
+func randomKeys(rnd io.Reader, n, kl int, flags bool) (Keyset, error) {
+	data := make([]byte, n*kl)
+	_, err := io.ReadFull(rnd, data)
+	if err != nil {
+		return nil, &EntropyError{err}
+	}
+	keys := make(Keyset, n)
+	for i := range keys {
+		keys[i].Data = data[i*kl : (i+1)*kl : (i+1)*kl]
+	}
+	if flags {
+		b := make([]byte, n)
+		_, err := io.ReadFull(rnd, b)
+		if err != nil {
+			return nil, &EntropyError{err}
+		}
+		for i := range keys {
+			keys[i].Invert = b[i]&1 == 1
+		}
+	}
+	return keys, nil
+}
+
+func randomFlag(rnd io.Reader) (bool, error) {
+	b := make([]byte, 1)
+	_, err := io.ReadFull(rnd, b)
+	if err != nil {
+		return false, &EntropyError{err}
+	}
+	return b[0]&1 == 1, nil
+}
+
+// NewKeyset generates a set of n keys, each with a random inversion flag, using
+// the default parameters and the package variable KeyLength. Randomness is read
+// from rnd, or from crypto/rand if rnd is nil, so a deterministic source
+// reproduces the same keyset.
+func NewKeyset(n int, rnd io.Reader) (Keyset, error) {
+	pr := DefaultParams()
+	return pr.NewKeyset(n, rnd)
+}
+
+// NewKeyset generates a set of n keys under the parameter set, see NewKeyset.
+func (pr *Params) NewKeyset(n int, rnd io.Reader) (Keyset, error) {
+	if n < 3 {
+		return nil, KeyCountError(n)
+	}
+	if err := pr.checkKeyLength(pr.KeyLength); err != nil {
+		return nil, err
+	}
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	g := pr.group()
+	keyset, err := randomKeys(rnd, n-1, pr.KeyLength*g.width, true)
+	if err != nil {
+		return nil, err
+	}
+	invert, err := randomFlag(rnd)
+	if err != nil {
+		return nil, err
+	}
+	return append(keyset, g.withInvert(g.complete(keyset), invert)), nil
+}
+
+// RandomKeyset generates a set of keys, each with a random inversion flag. The
+// size of the set is defined by keys. The length of each key is defined by the
+// package variable KeyLength. The last key is the compound key, chosen with
+// Complete so that the set cycles. It panics where NewKeyset would return an
+// error.
+func RandomKeyset(keys int) Keyset {
+	pr := DefaultParams()
+	return pr.RandomKeyset(keys)
+}
+
+// RandomKeyset generates a set of keys under the parameter set, see
+// RandomKeyset.
+func (pr *Params) RandomKeyset(keys int) Keyset {
+	keyset, err := pr.NewKeyset(keys, rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return keyset
+}
+
+// GenerateKeysetFor generates hop keys for a cycle that closes on an existing
+// recipient key. It draws hops-1 random keys and one balancing key, so that
+// applying all of the returned keys and recipient, in any order, returns the
+// original message. The keys have the same length as recipient. Randomness is
+// read from rnd, or from crypto/rand if rnd is nil.
+//
+// hops must be at least 2 so that the cycle has 3 keys: a single hop key would
+// be the inverse of the recipient's key, and would give it away to whoever
+// holds the hop. Fewer hops return a KeyCountError for the size of the cycle.
+// A recipient key that is empty, or not a whole number of symbols of the
+// group, returns a KeyLengthError.
+func GenerateKeysetFor(recipient Key, hops int, rnd io.Reader) (Keyset, error) {
+	return defaultParams.GenerateKeysetFor(recipient, hops, rnd)
+}
+
+// GenerateKeysetFor generates hop keys under the parameter set, see
+// GenerateKeysetFor.
+func (pr *Params) GenerateKeysetFor(recipient Key, hops int, rnd io.Reader) (Keyset, error) {
+	if hops < 2 {
+		return nil, KeyCountError(hops + 1)
+	}
+	g := pr.group()
+	if len(recipient.Data) == 0 || len(recipient.Data)%g.width != 0 {
+		return nil, KeyLengthError(len(recipient.Data))
+	}
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	keyset, err := randomKeys(rnd, hops-1, len(recipient.Data), true)
+	if err != nil {
+		return nil, err
+	}
+	invert, err := randomFlag(rnd)
+	if err != nil {
+		return nil, err
+	}
+	balance := g.complete(append(keyset, recipient))
+	return append(keyset, g.withInvert(balance, invert)), nil
+}
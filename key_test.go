@@ -0,0 +1,165 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	mrand "math/rand"
+	"testing"
+)
+
+func TestRandomKeysetCycle(t *testing.T) {
+	m := make([]byte, 10000)
+	rand.Read(m)
+	for n := 3; n < 8; n++ {
+		keys := RandomKeyset(n)
+		if len(keys) != n {
+			t.Error("Wrong number of keys")
+		}
+		// any order should cycle
+		c := m
+		for _, i := range mrand.Perm(n) {
+			c = keys[i].Apply(c)
+		}
+		if !bytes.Equal(m, c) {
+			t.Error("Did not cycle with", n, "keys")
+		}
+	}
+}
+
+func TestRandomKeysetFlags(t *testing.T) {
+	// with random flags, the compound key should be inverted about half the
+	// time
+	inverted := 0
+	for i := 0; i < 200; i++ {
+		keys := RandomKeyset(3)
+		if keys[2].Invert {
+			inverted++
+		}
+	}
+	if inverted == 0 || inverted == 200 {
+		t.Error("Compound key inversion flag is not random")
+	}
+}
+
+func TestGenerateKeysetFor(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	recipient := RandomKeyset(3)[0]
+	for hops := 2; hops < 5; hops++ {
+		keys, err := GenerateKeysetFor(recipient, hops, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != hops {
+			t.Error("Wrong number of keys")
+		}
+		c := CipherMany(m, keys)
+		if bytes.Equal(m, c) {
+			t.Error("Hop keys cycled without the recipient")
+		}
+		if !bytes.Equal(m, recipient.Apply(c)) {
+			t.Error("Did not cycle with", hops, "hops")
+		}
+	}
+
+	// a single hop would be the recipient's key inverted
+	for _, hops := range []int{-1, 0, 1} {
+		_, err := GenerateKeysetFor(recipient, hops, nil)
+		if e, ok := err.(KeyCountError); !ok || int(e) != hops+1 {
+			t.Error("Expected KeyCountError for", hops, "hops, got", err)
+		}
+	}
+
+	// the same source gives the same keys
+	seed := make([]byte, 100)
+	rand.Read(seed)
+	a, err := GenerateKeysetFor(recipient, 3, bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := GenerateKeysetFor(recipient, 3, bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range a {
+		if a[i].Invert != b[i].Invert || !bytes.Equal(a[i].Data, b[i].Data) {
+			t.Error("Same source produced different keys")
+		}
+	}
+
+	_, err = GenerateKeysetFor(recipient, 3, bytes.NewReader(seed[:5]))
+	if _, ok := err.(*EntropyError); !ok {
+		t.Error("Expected EntropyError, got", err)
+	}
+}
+
+func TestNewKeysetErrors(t *testing.T) {
+	for _, n := range []int{-1, 0, 1, 2} {
+		_, err := NewKeyset(n, nil)
+		if _, ok := err.(KeyCountError); !ok {
+			t.Error("Expected KeyCountError for", n, "keys, got", err)
+		}
+	}
+
+	pr := DefaultParams()
+	for _, kl := range []int{-1, 0} {
+		pr.KeyLength = kl
+		_, err := pr.NewKeyset(3, nil)
+		if _, ok := err.(KeyLengthError); !ok {
+			t.Error("Expected KeyLengthError for length", kl, "got", err)
+		}
+	}
+	for _, kl := range []int{127, 128} {
+		pr.KeyLength = kl
+		keys, err := pr.NewKeyset(3, nil)
+		if err != nil || len(keys[0].Data) != kl {
+			t.Error("Expected keys of length", kl, "got", err)
+		}
+	}
+
+	_, err := NewKeyset(3, bytes.NewReader(make([]byte, 5)))
+	if e, ok := err.(*EntropyError); !ok || e.Err != io.ErrUnexpectedEOF {
+		t.Error("Expected EntropyError, got", err)
+	}
+}
+
+func TestNewKeysetDeterministic(t *testing.T) {
+	seed := make([]byte, 100)
+	rand.Read(seed)
+	a, err := NewKeyset(4, bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewKeyset(4, bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range a {
+		if a[i].Invert != b[i].Invert || !bytes.Equal(a[i].Data, b[i].Data) {
+			t.Error("Same source produced different keysets")
+		}
+	}
+
+	m := make([]byte, 1000)
+	rand.Read(m)
+	if !bytes.Equal(m, CipherMany(m, a)) {
+		t.Error("Did not cycle")
+	}
+
+	pr := DefaultParams()
+	pr.KeyLength = 126
+	if _, err := pr.NewKeyset(3, nil); err != nil {
+		t.Error("Longest key length should be allowed:", err)
+	}
+
+	// keys longer than the root queue wrap around it
+	pr.KeyLength = 200
+	long, err := pr.NewKeyset(3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(m, pr.CipherMany(m, long)) {
+		t.Error("Long keys did not cycle")
+	}
+}
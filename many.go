@@ -0,0 +1,66 @@
+package cyclicKey
+
+// Because the cipher is multiplicative, applying several keys is the same as
+// multiplying each byte by the product of their key products. many keeps one
+// state per key and combines the key products for each chunk of the message,
+// so the message itself is only touched once.
+type many struct {
+	p   uint32
+	sts []state
+	kps [128]uint32
+}
+
+func newMany(pr *Params, keys []Key) *many {
+	m := &many{
+		p:   pr.byteGroup().p,
+		sts: make([]state, len(keys)),
+	}
+	for i, k := range keys {
+		m.sts[i].reset(pr, k.Data, k.Invert)
+	}
+	return m
+}
+
+// cipher applies every key to input, writing to output, and advances all of
+// the states by len(input) bytes.
+func (m *many) cipher(output, input []byte) {
+	if len(m.sts) == 1 {
+		m.sts[0].cipher(output, input)
+		return
+	}
+	p := m.p
+	for len(input) > 0 {
+		n := len(input)
+		if n > len(m.kps) {
+			n = len(m.kps)
+		}
+		kps := m.kps[:n]
+		for i := range kps {
+			kps[i] = 1
+		}
+		for k := range m.sts {
+			st := &m.sts[k]
+			st.products(st.kps[:n])
+			for i, kp := range st.kps[:n] {
+				kps[i] = (kps[i] * kp) % p
+			}
+		}
+		for i, kp := range kps {
+			output[i] = byte((((uint32(input[i]) + 1) * kp) % p) - 1)
+		}
+		input, output = input[n:], output[n:]
+	}
+}
+
+// CipherMany applies every key in keys to input in a single pass. The output
+// is the same as calling Cipher once for each key.
+func CipherMany(input []byte, keys Keyset) []byte {
+	return defaultParams.CipherMany(input, keys)
+}
+
+// CipherMany applies every key in keys under the parameter set, see CipherMany.
+func (pr *Params) CipherMany(input []byte, keys Keyset) []byte {
+	output := make([]byte, len(input))
+	newMany(pr, keys).cipher(output, input)
+	return output
+}
@@ -0,0 +1,53 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCipherManyMatchesCipher(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	keys := RandomKeyset(5)
+
+	expected := m
+	for _, k := range keys[:3] {
+		expected = k.Apply(expected)
+	}
+	if !bytes.Equal(expected, CipherMany(m, keys[:3])) {
+		t.Error("CipherMany does not match Cipher applied one key at a time")
+	}
+
+	if !bytes.Equal(m, CipherMany(m, keys)) {
+		t.Error("Whole keyset did not cycle")
+	}
+}
+
+func TestManyReader(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	keys := RandomKeyset(4)
+	expected := CipherMany(m, keys[1:])
+
+	r := NewReader(iotest.HalfReader(bytes.NewReader(m)), keys[1:]...)
+	got, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, got) {
+		t.Error("Reader output does not match CipherMany")
+	}
+}
+
+func BenchmarkCipherMany(b *testing.B) {
+	m := make([]byte, 100000)
+	rand.Read(m)
+	keys := RandomKeyset(4)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		CipherMany(m, keys)
+	}
+}
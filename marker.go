@@ -0,0 +1,259 @@
+package cyclicKey
+
+import (
+	"crypto/hmac"
+	"errors"
+)
+
+// ErrNoNonce is returned by MarkCycle when there is no nonce to mark the cycle
+// under.
+var ErrNoNonce = errors.New("cyclicKey: marking a cycle needs a nonce")
+
+// MarkerSize is the number of bytes of marker MarkCycle puts in front of a
+// payload. It also adds a tag of TagSize bytes behind it.
+const MarkerSize = 16
+
+// maxTraceSteps bounds how far from closed a Trace looks for an explanation.
+const maxTraceSteps = 3
+
+// MarkCycle wraps a payload in two things only the sender and the recipient
+// can compute, both derived from the recipient's key like an envelope tag: a
+// marker in front and an HMAC-SHA256 tag over the marker and payload behind.
+// They go through the cycle with the payload, and the hops, who can compute
+// neither, can't tell a closed cycle from an open one.
+//
+// The tag decides whether the cycle closed. A state that is any number of
+// keys away from closed, or that was changed along the way, passes it with
+// probability 2^-256.
+//
+// The marker is there to say what went wrong. After the recipient's key each
+// byte of it is off by the key products of the keys that were skipped or
+// repeated. The marker lies within a single rotation, where the key products
+// of a key come down to about 15 bits, so on its own it would be a weak check:
+// an open cycle would read it back 1 time in about 2^15. To turn the
+// difference into keys the recipient needs the key products of the other keys
+// over the marker, which the sender can give it in a Trace. Because the key
+// products of a whole cycle multiply out to 1, skipping some of the keys looks
+// exactly like applying all of the others once more, and the Trace reports
+// whichever takes fewer steps.
+//
+// Both the marker and the tag are keyed with the nonce, and MarkCycle won't
+// mark a cycle without one. Under a fixed nonce the marker would be the same
+// for every message to a recipient and would come out of each hop the same,
+// so the hops could link the messages by it. A fresh nonce per message gives
+// a fresh marker, and moves the key products as well, so a Trace only
+// explains the messages sent under the nonce it was built with.
+
+// CycleStatus is what CheckCycle can tell about a state.
+type CycleStatus struct {
+	// Closed is true when the tag checks out: every key was applied exactly
+	// once and nothing was changed.
+	Closed bool
+	// Steps is how many key applications the state is from closed, counting
+	// each skipped key and each extra application. It is 0 for a closed cycle
+	// and -1 when it isn't known: there was no Trace, the state is further
+	// than the Trace looks, or the keys were right but the state was changed.
+	Steps int
+	// Skipped and Repeated are the indexes, into the keyset the Trace was
+	// built from, of the keys that were missed and of the keys that were
+	// applied more than once, a key appearing once per extra application.
+	Skipped, Repeated []int
+	// Ambiguous is set when more than one set of Steps mistakes explains the
+	// state. Skipped and Repeated are then one of them. With the 15 or so
+	// bits the marker carries, a Trace of several keys gives a wrong
+	// explanation about once in a hundred checks.
+	Ambiguous bool
+}
+
+// Trace holds the exponents of the key products of every key in a keyset over
+// the marker. The sender builds it and hands it to the recipient along with
+// its key. It reveals part of every key in the set, so it must not travel
+// through the hops.
+type Trace struct {
+	s    uint32
+	exps [][]uint32
+}
+
+// MarkCycle returns payload between a cycle marker and tag for recipient,
+// under the default parameters and the nonce. Like an envelope, the result is
+// plaintext to be ciphered by every key of the cycle but recipient's, each
+// with the same nonce, see CipherNonce. Every message should get a new nonce
+// from NewNonce. An empty nonce returns ErrNoNonce.
+func MarkCycle(payload []byte, recipient Key, nonce []byte) ([]byte, error) {
+	pr := DefaultParams()
+	pr.Nonce = nonce
+	return pr.MarkCycle(payload, recipient)
+}
+
+// CheckCycle applies recipient's key to a state marked with MarkCycle under
+// the nonce and reports whether the cycle closed. The payload is only returned
+// when it has. tr may be nil, in which case all that is known of an open cycle
+// is that it is open.
+func CheckCycle(state []byte, recipient Key, nonce []byte, tr *Trace) ([]byte, CycleStatus) {
+	pr := DefaultParams()
+	pr.Nonce = nonce
+	return pr.CheckCycle(state, recipient, tr)
+}
+
+// NewTrace builds a Trace for the keyset under the default parameters and the
+// nonce. It only explains states marked under the same nonce.
+func NewTrace(keys Keyset, nonce []byte) *Trace {
+	pr := DefaultParams()
+	pr.Nonce = nonce
+	return pr.NewTrace(keys)
+}
+
+// MarkCycle marks payload under the parameter set, see MarkCycle. The nonce
+// of the parameter set must not be empty.
+func (pr *Params) MarkCycle(payload []byte, recipient Key) ([]byte, error) {
+	if len(pr.Nonce) == 0 {
+		return nil, ErrNoNonce
+	}
+	out := make([]byte, MarkerSize, MarkerSize+len(payload)+TagSize)
+	copy(out, pr.cycleMarker(recipient))
+	out = append(out, payload...)
+	return append(out, pr.cycleTag(out, recipient)...), nil
+}
+
+// CheckCycle checks a marked state under the parameter set, see CheckCycle.
+func (pr *Params) CheckCycle(state []byte, recipient Key, tr *Trace) ([]byte, CycleStatus) {
+	if len(state) < MarkerSize+TagSize {
+		return nil, CycleStatus{Steps: -1}
+	}
+	g := pr.byteGroup()
+	out := pr.Cipher(state, recipient.Data, recipient.Invert)
+	body, tag := out[:len(out)-TagSize], out[len(out)-TagSize:]
+	if hmac.Equal(tag, pr.cycleTag(body, recipient)) {
+		payload := body[MarkerSize:]
+		return payload[:len(payload):len(payload)], CycleStatus{Closed: true}
+	}
+
+	st := CycleStatus{Steps: -1}
+	if tr == nil {
+		return nil, st
+	}
+	// d is the exponent of the key product the state is off by at each byte
+	// of the marker
+	want := pr.cycleMarker(recipient)
+	d := make([]uint32, MarkerSize)
+	keysRight := true
+	for i := range d {
+		d[i] = (g.s + g.Log(uint32(out[i])+1) - g.Log(uint32(want[i])+1)) % g.s
+		keysRight = keysRight && d[i] == 0
+	}
+	// a marker that reads back under a bad tag means the state was changed,
+	// or it is the rare open cycle the marker can't see; neither is a number
+	// of steps
+	if !keysRight {
+		tr.explain(d, &st)
+	}
+	return nil, st
+}
+
+// NewTrace builds a Trace for the keyset under the parameter set, see
+// NewTrace.
+func (pr *Params) NewTrace(keys Keyset) *Trace {
+	g := pr.byteGroup()
+	tr := &Trace{
+		s:    g.s,
+		exps: make([][]uint32, len(keys)),
+	}
+	// ciphering zeros leaves kp-1 at each position
+	zeros := make([]byte, MarkerSize)
+	for i, k := range keys {
+		kps := pr.Cipher(zeros, k.Data, k.Invert)
+		e := make([]uint32, MarkerSize)
+		for j, kp := range kps {
+			e[j] = g.Log(uint32(kp) + 1)
+		}
+		tr.exps[i] = e
+	}
+	return tr
+}
+
+// cycleMarker returns the marker for recipient under the nonce.
+func (pr *Params) cycleMarker(recipient Key) []byte {
+	return pr.recipientMAC(recipient, "marker").Sum(nil)[:MarkerSize]
+}
+
+// cycleTag returns the tag for the marker and payload in body.
+func (pr *Params) cycleTag(body []byte, recipient Key) []byte {
+	mac := pr.recipientMAC(recipient, "cycle")
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// explain looks for the fewest skipped and repeated keys whose key products
+// add up to d, trying 1 step, then 2, up to maxTraceSteps.
+//
+// Move m < n is one extra application of key m, which adds its exponents, and
+// move n+k skips key k, which subtracts them. Moves are taken in ascending
+// order so that each set of moves is only tried once: a key can be repeated
+// any number of times but skipped only once, and never both.
+func (tr *Trace) explain(d []uint32, st *CycleStatus) {
+	n := len(tr.exps)
+	for steps := 1; steps <= maxTraceSteps; steps++ {
+		moves := make([]int, 0, steps)
+		var best []int
+		found := 0
+		var search func(from int, sum []uint32)
+		search = func(from int, sum []uint32) {
+			if len(moves) == steps {
+				for i := range sum {
+					if sum[i] != d[i] {
+						return
+					}
+				}
+				if found == 0 {
+					best = append(best, moves...)
+				}
+				found++
+				return
+			}
+			next := make([]uint32, len(sum))
+			for m := from; m < 2*n; m++ {
+				if m >= n && containsInt(moves, m-n) {
+					continue
+				}
+				e := tr.exps[m%n]
+				for i := range next {
+					if m < n {
+						next[i] = (sum[i] + e[i]) % tr.s
+					} else {
+						next[i] = (sum[i] + tr.s - e[i]) % tr.s
+					}
+				}
+				moves = append(moves, m)
+				if m < n {
+					search(m, next)
+				} else {
+					search(m+1, next)
+				}
+				moves = moves[:len(moves)-1]
+			}
+		}
+		search(0, make([]uint32, len(d)))
+		if found == 0 {
+			continue
+		}
+
+		st.Steps, st.Ambiguous = steps, found > 1
+		for _, m := range best {
+			if m < n {
+				st.Repeated = append(st.Repeated, m)
+			} else {
+				st.Skipped = append(st.Skipped, m-n)
+			}
+		}
+		return
+	}
+}
+
+func containsInt(s []int, v int) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
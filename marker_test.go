@@ -0,0 +1,159 @@
+package cyclicKey
+
+import (
+	"bytes"
+	mrand "math/rand"
+	"testing"
+)
+
+func TestCycleMarker(t *testing.T) {
+	// a fixed keyset, so that an ambiguous trace can't make the test flaky
+	keys, err := NewKeyset(5, mrand.New(mrand.NewSource(25)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipient, hops := keys[4], keys[:4]
+	pr := DefaultParams()
+	pr.Nonce = []byte("a fixed nonce")
+	tr := pr.NewTrace(keys)
+	payload := []byte("where did the relay go")
+	marked, err := pr.MarkCycle(payload, recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(marked) != len(payload)+MarkerSize+TagSize {
+		t.Error("Wrong marked length", len(marked))
+	}
+
+	got, st := pr.CheckCycle(pr.CipherMany(marked, hops), recipient, tr)
+	if !st.Closed || st.Steps != 0 || !bytes.Equal(payload, got) {
+		t.Error("Complete cycle did not close:", st)
+	}
+
+	tests := []struct {
+		name     string
+		keys     Keyset
+		skipped  []int
+		repeated []int
+	}{
+		{"skip", Keyset{hops[0], hops[1], hops[3]}, []int{2}, nil},
+		{"twice", Keyset{hops[0], hops[1], hops[1], hops[2], hops[3]}, nil, []int{1}},
+		{"skip two", Keyset{hops[1], hops[2]}, []int{0, 3}, nil},
+		{"skip and twice", Keyset{hops[0], hops[0], hops[1], hops[2]}, []int{3}, []int{0}},
+		{"recipient twice", Keyset{hops[0], hops[1], hops[2], hops[3], recipient}, nil, []int{4}},
+	}
+	for _, tc := range tests {
+		got, st := pr.CheckCycle(pr.CipherMany(marked, tc.keys), recipient, tr)
+		if st.Closed || got != nil {
+			t.Error(tc.name, ": open cycle reported closed")
+		}
+		if st.Ambiguous || st.Steps != len(tc.skipped)+len(tc.repeated) ||
+			!equalInts(st.Skipped, tc.skipped) || !equalInts(st.Repeated, tc.repeated) {
+			t.Errorf("%s: got %+v", tc.name, st)
+		}
+
+		// without a trace it is only known that the cycle is open
+		_, st = pr.CheckCycle(pr.CipherMany(marked, tc.keys), recipient, nil)
+		if st.Closed || st.Steps != -1 {
+			t.Errorf("%s: without a trace got %+v", tc.name, st)
+		}
+	}
+
+	// skipping every hop is the same as applying the recipient's key again,
+	// and the trace gives the shorter of the two
+	_, st = pr.CheckCycle(marked, recipient, tr)
+	if st.Steps != 1 || len(st.Skipped) != 0 || !equalInts(st.Repeated, []int{4}) {
+		t.Errorf("Unciphered marker: got %+v", st)
+	}
+
+	// too far from closed for the trace
+	_, st = pr.CheckCycle(pr.CipherMany(marked, Keyset{hops[2], hops[2], hops[3], hops[3]}), recipient, tr)
+	if st.Closed || st.Steps != -1 {
+		t.Errorf("Four steps: got %+v", st)
+	}
+
+	_, st = pr.CheckCycle(marked[:MarkerSize+TagSize-1], recipient, tr)
+	if st.Closed || st.Steps != -1 {
+		t.Errorf("Short state: got %+v", st)
+	}
+}
+
+func TestCycleMarkerNeverClosesOpen(t *testing.T) {
+	payload := make([]byte, 300)
+	for i := 0; i < 500; i++ {
+		keys := RandomKeyset(4)
+		recipient, hops := keys[3], keys[:3]
+		nonce, err := NewNonce(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pr := DefaultParams()
+		pr.Nonce = nonce
+		marked, err := MarkCycle(payload, recipient, nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		full := pr.CipherMany(marked, hops)
+		skipped := pr.CipherMany(marked, hops[1:])
+		if got, st := CheckCycle(skipped, recipient, nonce, nil); st.Closed || got != nil {
+			t.Fatal("A skipped hop reported Closed")
+		}
+
+		// the marker only carries about 15 bits, so an open cycle can read
+		// it back; splice the marker of a closed state onto an open one to
+		// get that case every time
+		spliced := append(append([]byte(nil), full[:MarkerSize]...), skipped[MarkerSize:]...)
+		if got, st := CheckCycle(spliced, recipient, nonce, NewTrace(keys, nonce)); st.Closed || got != nil || st.Steps != -1 {
+			t.Fatalf("A marker that reads back on an open cycle gave %+v", st)
+		}
+
+		// the keys are right but a hop changed a byte of the payload
+		full[MarkerSize+i%len(payload)] ^= 1
+		if _, st := CheckCycle(full, recipient, nonce, nil); st.Closed {
+			t.Fatal("A changed payload reported Closed")
+		}
+	}
+}
+
+func TestCycleMarkerNonce(t *testing.T) {
+	keys := RandomKeyset(3)
+	recipient, hops := keys[2], keys[:2]
+	if _, err := MarkCycle(nil, recipient, nil); err != ErrNoNonce {
+		t.Error("Expected ErrNoNonce, got", err)
+	}
+
+	// the same payload for the same recipient leaves each hop with a
+	// different marker under each nonce
+	var seen [][]byte
+	for i := 0; i < 2; i++ {
+		nonce, err := NewNonce(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		marked, err := MarkCycle(nil, recipient, nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state := hops[0].ApplyNonce(marked, nonce)
+		seen = append(seen, state[:MarkerSize])
+		state = hops[1].ApplyNonce(state, nonce)
+		if _, st := CheckCycle(state, recipient, nonce, nil); !st.Closed {
+			t.Error("Cycle did not close under its nonce")
+		}
+	}
+	if bytes.Equal(seen[0], seen[1]) {
+		t.Error("Marker is the same under two nonces")
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package cyclicKey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+)
+
+// NonceSize is the length of the nonces NewNonce generates. A nonce of any
+// length works, it only has to be unique for the keyset.
+const NonceSize = 16
+
+// Without a nonce, a key produces the same key products for every message, so
+// equal plaintexts give equal ciphertexts and the key stream of one message is
+// the key stream of the next. A nonce moves the message to a different place
+// in the schedule: it starts at a different index in the root queue, and the
+// rotation values are masked with an xorShift stream seeded from the nonce,
+// which gives every rotation vector new multipliers.
+//
+// Every key in the cycle does the same to the schedule, so at each position all
+// of the keys still share their roots and multipliers and the key products
+// still multiply out to 1. The nonce is public and travels with the message.
+// It stops messages from sharing multipliers, but it is not a secret: anyone
+// who has the nonce can still write out the linear system for a known
+// plaintext, see Coefficients.
+
+// CipherNonce applies key to input under the default parameters and the
+// nonce. Every key in the cycle must be applied with the same nonce. An empty
+// nonce is the same as Cipher.
+func CipherNonce(input, key []byte, invert bool, nonce []byte) []byte {
+	pr := defaultParams
+	pr.Nonce = nonce
+	return pr.Cipher(input, key, invert)
+}
+
+// ApplyNonce ciphers msg with the key under the default parameters and the
+// nonce, see CipherNonce.
+func (k Key) ApplyNonce(msg, nonce []byte) []byte {
+	return CipherNonce(msg, k.Data, k.Invert, nonce)
+}
+
+// NewNonce reads a nonce of NonceSize bytes from rnd, or from crypto/rand if
+// rnd is nil.
+func NewNonce(rnd io.Reader) ([]byte, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	nonce := make([]byte, NonceSize)
+	_, err := io.ReadFull(rnd, nonce)
+	if err != nil {
+		return nil, &EntropyError{err}
+	}
+	return nonce, nil
+}
+
+// nonceShift derives from the nonce the position in the schedule a message
+// starts at, which is less than the number of roots, and the seeds of the
+// stream that masks the rotation values. ok is false when there is no nonce.
+func (pr *Params) nonceShift() (base int64, seeds [4]uint32, ok bool) {
+	if len(pr.Nonce) == 0 {
+		return 0, seeds, false
+	}
+	h := sha256.New()
+	h.Write([]byte("cyclicKey nonce"))
+	h.Write(pr.Nonce)
+	var d [sha256.Size]byte
+	h.Sum(d[:0])
+
+	base = int64(binary.LittleEndian.Uint32(d[0:]) % pr.group().roots)
+	for i := range seeds {
+		seeds[i] = binary.LittleEndian.Uint32(d[4+4*i:])
+	}
+	// xorShift never leaves the all zero state
+	if seeds == [4]uint32{} {
+		seeds[0] = 1
+	}
+	return base, seeds, true
+}
+
+// maskedSource XORs a second stream into the values of a rotation source.
+type maskedSource struct {
+	src  RotationSource
+	mask XorShift
+}
+
+func (m *maskedSource) Next() uint32 {
+	return m.src.Next() ^ m.mask.Next()
+}
@@ -0,0 +1,102 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestNonceCycle(t *testing.T) {
+	m := make([]byte, 5000)
+	rand.Read(m)
+	nonce, err := NewNonce(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := RandomKeyset(4)
+	c := m
+	for _, k := range keys {
+		c = k.ApplyNonce(c, nonce)
+	}
+	if !bytes.Equal(m, c) {
+		t.Error("Did not cycle with a nonce")
+	}
+
+	for _, kl := range []int{1, 10, 200} {
+		pr := DefaultParams()
+		pr.KeyLength = kl
+		keys := pr.RandomKeyset(3)
+		pr.Nonce = nonce
+		if !bytes.Equal(m, pr.CipherMany(m, keys)) {
+			t.Error("Did not cycle with a nonce and key length", kl)
+		}
+	}
+}
+
+func TestNonceKeystream(t *testing.T) {
+	// ciphering zeros exposes kp-1 directly
+	m := make([]byte, 20000)
+	key := RandomKeyset(3)[0]
+	a := key.ApplyNonce(m, []byte("first"))
+	b := key.ApplyNonce(m, []byte("second"))
+	if !bytes.Equal(a, key.ApplyNonce(m, []byte("first"))) {
+		t.Error("The same nonce gave different ciphertexts")
+	}
+	same := 0
+	for i := range a {
+		if a[i] == b[i] {
+			same++
+		}
+	}
+	// unrelated key streams agree at about 1 position in 256
+	if same > len(m)/64 {
+		t.Error("Key streams under different nonces agree at", same, "positions")
+	}
+
+	// the rotation vectors don't share multipliers any more than chance
+	pr := DefaultParams()
+	pr.Nonce = []byte("first")
+	ra := pr.Rotations(KeyLength)
+	pr.Nonce = []byte("second")
+	rb := pr.Rotations(KeyLength)
+	shared := 0
+	for n := 0; n < 1000; n++ {
+		ma, mb := ra.Next(), rb.Next()
+		// the last multiplier is never rotated
+		for j := 0; j < KeyLength-1; j++ {
+			if ma[j] == mb[j] {
+				shared++
+			}
+		}
+	}
+	if shared > 1000*(KeyLength-1)/64 {
+		t.Error("Rotations under different nonces share", shared, "multipliers")
+	}
+}
+
+func TestNonceEmpty(t *testing.T) {
+	m := make([]byte, 1000)
+	rand.Read(m)
+	key := RandomKeyset(3)[1]
+	c := key.Apply(m)
+	for _, nonce := range [][]byte{nil, {}} {
+		if !bytes.Equal(c, key.ApplyNonce(m, nonce)) {
+			t.Error("An empty nonce changed the ciphertext")
+		}
+	}
+}
+
+func TestNonceSeek(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	pr := DefaultParams()
+	pr.Nonce = []byte("seek")
+	key := pr.RandomKeyset(3)[0]
+	c := pr.Cipher(m, key.Data, key.Invert)
+	for _, off := range []int64{1, 127, 128, 1000, 2999} {
+		if !bytes.Equal(c[off:], pr.CipherAt(m[off:], key, off)) {
+			t.Error("CipherAt with a nonce does not match at offset", off)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package cyclicKey
+
+import (
+	"crypto/rand"
+)
+
+// Params is a complete parameter set for the cipher. Keys are only meaningful
+// under the parameters that generated them. Because a Params is a plain value,
+// programs can use several parameter sets at once, for example keysets of
+// different lengths on different goroutines, without touching package state.
+type Params struct {
+	// KeyLength is the number of symbols in a generated key. For Group257 a
+	// symbol is one byte.
+	KeyLength int
+	// Seeds are the xorShift seeds for the key rotation. xorShift never leaves
+	// the all zero state, so all zero means the default seeds.
+	Seeds [4]uint32
+	// Rotation returns a fresh source for the key rotation, positioned at the
+	// start of a message. nil means xorShift seeded with Seeds.
+	Rotation func() RotationSource
+	// Nonce is the public per-message nonce, see CipherNonce. Every key that
+	// ciphers a message has to use the same nonce. Empty means none.
+	Nonce []byte
+	// Group is the prime and tables, nil means Group257
+	Group *Group
+}
+
+// defaultParams backs the package level functions. KeyLength is filled in from
+// the package variable when it is needed.
+var defaultParams = Params{
+	Seeds: [4]uint32{seed1, seed2, seed3, seed4},
+	Group: Group257,
+}
+
+// DefaultParams returns the parameters used by the package level functions,
+// with KeyLength taken from the package variable of the same name.
+func DefaultParams() Params {
+	pr := defaultParams
+	pr.KeyLength = KeyLength
+	return pr
+}
+
+// rotationSource returns a fresh rotation source for the parameter set.
+func (pr *Params) rotationSource() RotationSource {
+	var src RotationSource
+	if pr.Rotation == nil {
+		src = NewXorShift(pr.seeds())
+	} else {
+		src = pr.Rotation()
+	}
+	if _, seeds, ok := pr.nonceShift(); ok {
+		src = &maskedSource{src: src, mask: *NewXorShift(seeds)}
+	}
+	return src
+}
+
+// seeds returns the xorShift seeds, filling in the defaults for zero seeds.
+func (pr *Params) seeds() [4]uint32 {
+	if pr.Seeds == [4]uint32{} {
+		return defaultParams.Seeds
+	}
+	return pr.Seeds
+}
+
+func (pr *Params) group() *Group {
+	if pr.Group == nil {
+		return Group257
+	}
+	return pr.Group
+}
+
+// byteGroup returns the group, panicking if its symbols are not bytes.
+func (pr *Params) byteGroup() *Group {
+	g := pr.group()
+	if g.pm == nil {
+		panic("cyclicKey: group symbols are not bytes, use CipherSymbols")
+	}
+	return g
+}
+
+// Cipher applies key to input under the parameter set, see Cipher. The group
+// must have one byte symbols, as Group257 does.
+func (pr *Params) Cipher(input, key []byte, invert bool) []byte {
+	output := make([]byte, len(input))
+	pr.CipherTo(output, input, key, invert)
+	return output
+}
+
+// checkKeyLength returns a KeyLengthError if keys of length kl can't be used.
+func (pr *Params) checkKeyLength(kl int) error {
+	if kl < 1 {
+		return KeyLengthError(kl)
+	}
+	return nil
+}
+
+// GenerateKeyset generates a set of keys under the parameter set, see
+// GenerateKeyset.
+func (pr *Params) GenerateKeyset(keys int) [][]byte {
+	g := pr.group()
+	keyset := make([][]byte, keys)
+	random, err := randomKeys(rand.Reader, keys-1, pr.KeyLength*g.width, false)
+	if err != nil {
+		panic(err)
+	}
+	for i, k := range random {
+		keyset[i] = k.Data
+	}
+	keyset[keys-1] = g.withInvert(g.complete(random), true).Data
+	return keyset
+}
+
+// CipherSymbols applies key to a stream of symbols in [0, p-2]. This works
+// with any group, use Group.ToSymbols and Group.FromSymbols to convert to and
+// from bytes.
+func (pr *Params) CipherSymbols(input []uint32, key []byte, invert bool) []uint32 {
+	output := make([]uint32, len(input))
+	newState(pr, key, invert).cipherSymbols(output, input)
+	return output
+}
+
+// ApplySymbols applies k to a stream of symbols under the parameter set, see
+// CipherSymbols.
+func (pr *Params) ApplySymbols(symbols []uint32, k Key) []uint32 {
+	return pr.CipherSymbols(symbols, k.Data, k.Invert)
+}
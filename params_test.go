@@ -0,0 +1,96 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"sync"
+	"testing"
+)
+
+func TestParamsKeyLength(t *testing.T) {
+	// keysets of different lengths on different goroutines without touching
+	// the package variable
+	m := make([]byte, 1000)
+	rand.Read(m)
+	var wg sync.WaitGroup
+	for kl := 1; kl < 20; kl++ {
+		wg.Add(1)
+		go func(kl int) {
+			defer wg.Done()
+			pr := DefaultParams()
+			pr.KeyLength = kl
+			keys := pr.RandomKeyset(3)
+			if len(keys[0].Data) != kl {
+				t.Error("Wrong key length", len(keys[0].Data), kl)
+			}
+			if !bytes.Equal(m, pr.CipherMany(m, keys)) {
+				t.Error("Did not cycle with key length", kl)
+			}
+		}(kl)
+	}
+	wg.Wait()
+}
+
+func TestParamsSeeds(t *testing.T) {
+	m := make([]byte, 1000)
+	rand.Read(m)
+	pr := DefaultParams()
+	pr.Seeds = [4]uint32{1, 2, 3, 4}
+	keys := pr.GenerateKeyset(3)
+
+	c := m
+	for i, k := range keys {
+		c = pr.Cipher(c, k, i == len(keys)-1)
+	}
+	if !bytes.Equal(m, c) {
+		t.Error("Did not cycle with different seeds")
+	}
+	if bytes.Equal(Cipher(m, keys[0], false), pr.Cipher(m, keys[0], false)) {
+		t.Error("Seeds had no effect")
+	}
+
+	// keys encoded under one parameter set are rejected by another
+	b, err := pr.EncodeKey(Key{Data: keys[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pr.DecodeKey(b); err != nil {
+		t.Error(err)
+	}
+	var k Key
+	if err := k.UnmarshalBinary(b); err != ErrParams {
+		t.Error("Expected ErrParams, got", err)
+	}
+}
+
+func TestDefaultParams(t *testing.T) {
+	m := make([]byte, 1000)
+	rand.Read(m)
+	key := RandomKeyset(3)[0]
+	pr := DefaultParams()
+	if pr.KeyLength != KeyLength {
+		t.Error("DefaultParams did not take KeyLength from the package")
+	}
+	if !bytes.Equal(key.Apply(m), pr.Cipher(m, key.Data, key.Invert)) {
+		t.Error("DefaultParams does not match the package level Cipher")
+	}
+
+	// the zero value falls back to Group257 and the default seeds
+	var zero Params
+	if zero.group() != Group257 {
+		t.Error("Zero Params should use Group257")
+	}
+	c := key.Apply(m)
+	if !bytes.Equal(c, zero.Cipher(m, key.Data, key.Invert)) {
+		t.Error("Zero Params does not match the package level Cipher")
+	}
+	if zero.tag() != defaultParams.tag() {
+		t.Error("Zero Params should have the default tag")
+	}
+
+	// the seeds are part of the cipher
+	pr.Seeds[0]++
+	if bytes.Equal(c, pr.Cipher(m, key.Data, key.Invert)) {
+		t.Error("Changing Seeds did not change the output")
+	}
+}
@@ -0,0 +1,107 @@
+package cyclicKey
+
+// Rotations steps through the rotation multipliers the cipher uses for keys of
+// a given length. Key symbol j is used as the exponent (k[j]+1)*m[j] mod s,
+// where m is the current rotation vector. The first vector covers the start of
+// the message and each later one replaces it every time the root queue wraps.
+// The last key symbol is never rotated, so the last multiplier is the same in
+// every vector.
+//
+// Two vectors that share multipliers in many positions reuse the same
+// exponents for those key symbols, which is what the choice of seeds and
+// rotation source is meant to avoid.
+//
+// With a nonce the message starts base symbols into the schedule, so the
+// vectors that are replaced before the message starts are skipped.
+type Rotations struct {
+	g    *Group
+	src  RotationSource
+	m    []uint32
+	n    int64
+	base int64
+}
+
+// Rotations returns the rotation vectors for keys of kl symbols under the
+// parameter set.
+func (pr *Params) Rotations(kl int) *Rotations {
+	base, _, _ := pr.nonceShift()
+	return &Rotations{
+		g:    pr.group(),
+		src:  pr.rotationSource(),
+		m:    make([]uint32, kl),
+		n:    -1,
+		base: base,
+	}
+}
+
+// Next advances to the next rotation and returns its multipliers, each in
+// [1, s]. The first call returns the vector the message starts with. The
+// returned slice is reused by the following call.
+func (r *Rotations) Next() []uint32 {
+	first := r.n < 0
+	r.step()
+	for first && rotationOffset(r.g, len(r.m), r.n+1) <= r.base {
+		r.step()
+	}
+	return r.m
+}
+
+func (r *Rotations) step() {
+	rotated := len(r.m) - 1
+	if r.n < 0 {
+		rotated = len(r.m)
+	}
+	for j := 0; j < rotated; j++ {
+		r.m[j] = r.src.Next()%r.g.s + 1
+	}
+	r.n++
+}
+
+// Offset returns the position in the message, in symbols, from which the
+// vector last returned by Next is used.
+func (r *Rotations) Offset() int64 {
+	off := rotationOffset(r.g, len(r.m), r.n) - r.base
+	if off < 0 {
+		return 0
+	}
+	return off
+}
+
+// rotationOffset returns the position from which rotation n is used for keys
+// of kl symbols.
+func rotationOffset(g *Group, kl int, n int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+	roots := int64(g.roots)
+	first := ((roots-2-int64(kl))%roots + roots) % roots
+	return first + 1 + (n-1)*roots
+}
+
+// Coefficients returns, for n symbols of a message from offset on, how much
+// each of the kl key symbols contributes to the exponent of the key product.
+// With x[j] = k[j]+1, or -(k[j]+1) mod s for an inverted key, the key product
+// at position i is Generator raised to the sum over j of c[i][j]*x[j] mod s,
+// where c[i][j] is the root exponent at the position times the rotation
+// multiplier. This is the linear system a known plaintext gives.
+func (pr *Params) Coefficients(kl int, offset int64, n int) [][]uint32 {
+	g := pr.group()
+	rs := pr.Rotations(kl)
+	m := rs.Next()
+	roots := int64(g.roots)
+
+	out := make([][]uint32, n)
+	for i := range out {
+		pos := rs.base + offset + int64(i)
+		for pos >= rotationOffset(g, kl, rs.n+1) {
+			m = rs.Next()
+		}
+		row := make([]uint32, kl)
+		for j := range row {
+			e := uint64(g.rootExp[(pos+int64(j))%roots])
+			row[j] = uint32(e * uint64(m[j]) % uint64(g.s))
+		}
+		out[i] = row
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package cyclicKey
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+func TestRotationsMatchCipher(t *testing.T) {
+	for _, nonce := range [][]byte{nil, []byte("nonce")} {
+		pr := DefaultParams()
+		pr.Nonce = nonce
+		g := pr.group()
+		for _, kl := range []int{1, 2, 10, 130} {
+			key := make([]byte, kl)
+			rand.Read(key)
+			st := newState(&pr, key, false)
+			rs := pr.Rotations(kl)
+			prev := make([]uint32, kl)
+			for n := 0; n < 20; n++ {
+				m := rs.Next()
+				if n > 0 {
+					// the symbol before the offset still uses the previous vector
+					st.seek(rs.Offset() - 1)
+					if !rotatedBy(g, st.k32, key, prev) {
+						t.Fatal("Rotation", n, "starts too early with key length", kl, "and nonce", nonce)
+					}
+				}
+				st.seek(rs.Offset())
+				if !rotatedBy(g, st.k32, key, m) {
+					t.Fatal("Rotation", n, "does not match the cipher with key length", kl, "and nonce", nonce)
+				}
+				copy(prev, m)
+			}
+		}
+	}
+}
+
+func rotatedBy(g *Group, k32 []uint32, key []byte, m []uint32) bool {
+	for j, k := range k32 {
+		if k != (uint32(key[j])+1)*m[j]%g.s {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCoefficients(t *testing.T) {
+	m := make([]byte, 700)
+	rand.Read(m)
+	for _, nonce := range [][]byte{nil, []byte("nonce")} {
+		pr := DefaultParams()
+		pr.Nonce = nonce
+		g := pr.group()
+		for _, kl := range []int{1, 3, 10, 200} {
+			key := Key{Data: make([]byte, kl), Invert: kl%2 == 1}
+			rand.Read(key.Data)
+			x := make([]uint32, kl)
+			for j, k := range key.Data {
+				x[j] = uint32(k) + 1
+				if key.Invert {
+					x[j] = g.s - x[j]
+				}
+			}
+			c := pr.Cipher(m, key.Data, key.Invert)
+			for _, off := range []int64{0, 300} {
+				for i, row := range pr.Coefficients(kl, off, len(m)-int(off)) {
+					e := uint64(0)
+					for j, cf := range row {
+						e += uint64(cf) * uint64(x[j])
+					}
+					pos := int(off) + i
+					kp := g.Exp(uint32(e % uint64(g.s)))
+					if (uint32(m[pos])+1)*kp%g.p != uint32(c[pos])+1 {
+						t.Fatal("Coefficients do not match the cipher at", pos, "with key length", kl, "and nonce", nonce)
+					}
+				}
+			}
+		}
+	}
+}
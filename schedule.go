@@ -0,0 +1,83 @@
+package cyclicKey
+
+import (
+	"sync"
+)
+
+// Schedule is a key compiled for reuse across many messages. The key product
+// at each position depends only on the key and the position, so a Schedule
+// computes each one once, the first time a message reaches that position, and
+// remembers it. After that, ciphering is a single table lookup and multiply per
+// byte.
+//
+// The memo grows to the length of the longest message ciphered, one byte per
+// position, so a Schedule is best suited to many short messages such as onion
+// cells. A Schedule is safe for concurrent use.
+type Schedule struct {
+	mu sync.Mutex
+	st state
+	// kps holds kp-1 for every position computed so far. It is only ever
+	// appended to, so a slice taken under the lock stays valid after it is
+	// released.
+	kps []byte
+}
+
+// NewSchedule compiles key into a Schedule.
+func NewSchedule(key Key) *Schedule {
+	return defaultParams.NewSchedule(key)
+}
+
+// NewSchedule compiles key into a Schedule under the parameter set.
+func (pr *Params) NewSchedule(key Key) *Schedule {
+	pr.byteGroup()
+	sc := &Schedule{}
+	sc.st.reset(pr, append([]byte(nil), key.Data...), key.Invert)
+	return sc
+}
+
+// products returns the memoized key products for the first n positions,
+// computing any that are missing.
+func (sc *Schedule) products(n int) []byte {
+	sc.mu.Lock()
+	for len(sc.kps) < n {
+		c := n - len(sc.kps)
+		if c > len(sc.st.kps) {
+			c = len(sc.st.kps)
+		}
+		kps := sc.st.kps[:c]
+		sc.st.products(kps)
+		for _, kp := range kps {
+			sc.kps = append(sc.kps, byte(kp-1))
+		}
+	}
+	kps := sc.kps[:n]
+	sc.mu.Unlock()
+	return kps
+}
+
+// Precompute fills the memo for the first n positions so that later calls do
+// not pay for it.
+func (sc *Schedule) Precompute(n int) {
+	sc.products(n)
+}
+
+// CipherTo applies the key to src and writes the result to dst, which must be
+// at least as long as src. dst and src may be the same slice to cipher in
+// place, otherwise they must not overlap.
+func (sc *Schedule) CipherTo(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("cyclicKey: output smaller than input")
+	}
+	kps := sc.products(len(src))
+	p := sc.st.g.p
+	for i, kp := range kps {
+		dst[i] = byte((((uint32(src[i]) + 1) * (uint32(kp) + 1)) % p) - 1)
+	}
+}
+
+// Apply applies the key to msg, returning a new slice.
+func (sc *Schedule) Apply(msg []byte) []byte {
+	out := make([]byte, len(msg))
+	sc.CipherTo(out, msg)
+	return out
+}
@@ -0,0 +1,65 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"sync"
+	"testing"
+)
+
+func TestScheduleMatchesCipher(t *testing.T) {
+	key := RandomKeyset(3)[1]
+	sc := NewSchedule(key)
+	// short then long then short, so the memo is both used and extended
+	for _, l := range []int{10, 500, 1000, 20, 1000} {
+		m := make([]byte, l)
+		rand.Read(m)
+		if !bytes.Equal(key.Apply(m), sc.Apply(m)) {
+			t.Error("Schedule output does not match Cipher for length", l)
+		}
+	}
+}
+
+func TestScheduleConcurrent(t *testing.T) {
+	key := RandomKeyset(3)[0]
+	sc := NewSchedule(key)
+	m := make([]byte, 2000)
+	rand.Read(m)
+	expected := key.Apply(m)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(l int) {
+			defer wg.Done()
+			if !bytes.Equal(expected[:l], sc.Apply(m[:l])) {
+				t.Error("Schedule output does not match Cipher for length", l)
+			}
+		}(250 * (g + 1))
+	}
+	wg.Wait()
+}
+
+func TestScheduleCycle(t *testing.T) {
+	m := make([]byte, 512)
+	rand.Read(m)
+	c := m
+	for _, k := range RandomKeyset(5) {
+		c = NewSchedule(k).Apply(c)
+	}
+	if !bytes.Equal(m, c) {
+		t.Error("Did not cycle")
+	}
+}
+
+func BenchmarkSchedule(b *testing.B) {
+	m := make([]byte, 512)
+	rand.Read(m)
+	sc := NewSchedule(RandomKeyset(3)[0])
+	sc.Precompute(len(m))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		sc.CipherTo(m, m)
+	}
+}
@@ -0,0 +1,57 @@
+package cyclicKey
+
+import (
+	"sync"
+)
+
+// Scratch holds the working state of the cipher so that it can be reused from
+// one message to the next. Once a Scratch has seen a key of a given length,
+// ciphering with keys up to that length does no heap allocation. A Scratch is
+// not safe for concurrent use.
+type Scratch struct {
+	// Params is the parameter set to cipher under, nil means the defaults
+	Params *Params
+	st     state
+}
+
+// CipherTo applies key to src and writes the result to dst, using the Scratch
+// for all of the intermediate state. dst must be at least as long as src. dst
+// and src may be the same slice to cipher in place, otherwise they must not
+// overlap.
+func (sc *Scratch) CipherTo(dst, src, key []byte, invert bool) {
+	if len(dst) < len(src) {
+		panic("cyclicKey: output smaller than input")
+	}
+	pr := sc.Params
+	if pr == nil {
+		pr = &defaultParams
+	}
+	sc.st.reset(pr, key, invert)
+	sc.st.cipher(dst, src)
+	// don't hold on to the caller's key
+	sc.st.key = nil
+}
+
+var scratchPool = sync.Pool{
+	New: func() interface{} {
+		return &Scratch{}
+	},
+}
+
+// CipherTo is the allocation free form of Cipher. It applies key to src and
+// writes the result to dst, which must be at least as long as src. dst and src
+// may be the same slice to cipher in place, otherwise they must not overlap.
+// The scratch state comes from a pool, so the hot path does no heap
+// allocation.
+func CipherTo(dst, src, key []byte, invert bool) {
+	defaultParams.CipherTo(dst, src, key, invert)
+}
+
+// CipherTo is the allocation free form of Params.Cipher, see CipherTo.
+func (pr *Params) CipherTo(dst, src, key []byte, invert bool) {
+	sc := scratchPool.Get().(*Scratch)
+	sc.Params = pr
+	sc.CipherTo(dst, src, key, invert)
+	sc.Params = nil
+	scratchPool.Put(sc)
+}
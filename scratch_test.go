@@ -0,0 +1,68 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestCipherToInPlace(t *testing.T) {
+	m := make([]byte, 1000)
+	rand.Read(m)
+	key := RandomKeyset(3)[0]
+	expected := key.Apply(m)
+
+	CipherTo(m, m, key.Data, key.Invert)
+	if !bytes.Equal(expected, m) {
+		t.Error("In place output does not match Cipher")
+	}
+}
+
+func TestScratchReuse(t *testing.T) {
+	m := make([]byte, 1000)
+	rand.Read(m)
+	var sc Scratch
+	c := make([]byte, len(m))
+	// a long key followed by a short one to make sure reused buffers are
+	// trimmed
+	for _, kl := range []int{20, 5, 10} {
+		key := make([]byte, kl)
+		rand.Read(key)
+		sc.CipherTo(c, m, key, true)
+		if !bytes.Equal(Cipher(m, key, true), c) {
+			t.Error("Scratch output does not match Cipher for key length", kl)
+		}
+	}
+}
+
+func TestCipherToAllocs(t *testing.T) {
+	m := make([]byte, 512)
+	rand.Read(m)
+	c := make([]byte, len(m))
+	key := RandomKeyset(3)[0]
+	allocs := testing.AllocsPerRun(100, func() {
+		CipherTo(c, m, key.Data, key.Invert)
+	})
+	if allocs != 0 {
+		t.Error("Expected no allocations, got", allocs)
+	}
+
+	var sc Scratch
+	allocs = testing.AllocsPerRun(100, func() {
+		sc.CipherTo(m, m, key.Data, key.Invert)
+	})
+	if allocs != 0 {
+		t.Error("Expected no allocations, got", allocs)
+	}
+}
+
+func BenchmarkCipherTo(b *testing.B) {
+	m := make([]byte, 512)
+	rand.Read(m)
+	key := RandomKeyset(3)[0]
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		CipherTo(m, m, key.Data, key.Invert)
+	}
+}
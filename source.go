@@ -0,0 +1,125 @@
+package cyclicKey
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// RotationSource produces the values the key rotation is drawn from. Each time
+// the root queue wraps, every key symbol but the last takes the next value v
+// and is used as the exponent (k+1)*((v % s)+1) mod s, so for Group257 the
+// multiplier is (v & 255) + 1.
+//
+// The cipher asks Params.Rotation for a fresh source at the start of every
+// message, and again whenever it seeks, so a source must always produce the
+// same sequence from the start.
+type RotationSource interface {
+	Next() uint32
+}
+
+// XorShift is the xorShift generator the cipher has always used for the key
+// rotation. It is the default rotation source, seeded with Params.Seeds.
+type XorShift struct {
+	xs1, xs2, xs3, xs4 uint32
+}
+
+// NewXorShift returns an xorShift rotation source. The seeds must not all be
+// zero.
+func NewXorShift(seeds [4]uint32) *XorShift {
+	return &XorShift{seeds[0], seeds[1], seeds[2], seeds[3]}
+}
+
+// Next steps the generator and returns xs4.
+func (x *XorShift) Next() uint32 {
+	x.xs1, x.xs2, x.xs3, x.xs4 = xorShift(x.xs1, x.xs2, x.xs3, x.xs4)
+	return x.xs4
+}
+
+// SplitMix64 is the SplitMix64 generator. It is as fast as xorShift but every
+// output bit depends on every bit of the counter, where the low byte of an
+// xorShift output only mixes a few bits of the state.
+type SplitMix64 struct {
+	state uint64
+}
+
+// NewSplitMix64 returns a SplitMix64 rotation source.
+func NewSplitMix64(seed uint64) *SplitMix64 {
+	return &SplitMix64{seed}
+}
+
+// Next returns the high 32 bits of the next output.
+func (sm *SplitMix64) Next() uint32 {
+	sm.state += 0x9e3779b97f4a7c15
+	z := sm.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return uint32((z ^ (z >> 31)) >> 32)
+}
+
+// ChaCha20 is a rotation source built on the ChaCha20 block function from RFC
+// 8439. It is the only source here whose sequence can't be predicted from a few
+// of its outputs, but it is still only the rotation: the cipher around it is
+// no stronger than its weakest part.
+type ChaCha20 struct {
+	state [16]uint32
+	block [16]uint32
+	i     int
+}
+
+// NewChaCha20 returns a ChaCha20 rotation source for the key and nonce,
+// starting at block counter 0.
+func NewChaCha20(key [32]byte, nonce [12]byte) *ChaCha20 {
+	c := &ChaCha20{i: 16}
+	c.state[0], c.state[1], c.state[2], c.state[3] = 0x61707865, 0x3320646e, 0x79622d32, 0x6b206574
+	for i := 0; i < 8; i++ {
+		c.state[4+i] = binary.LittleEndian.Uint32(key[4*i:])
+	}
+	for i := 0; i < 3; i++ {
+		c.state[13+i] = binary.LittleEndian.Uint32(nonce[4*i:])
+	}
+	return c
+}
+
+// Next returns the next word of the key stream.
+func (c *ChaCha20) Next() uint32 {
+	if c.i == 16 {
+		chachaBlock(&c.block, &c.state)
+		c.state[12]++
+		c.i = 0
+	}
+	v := c.block[c.i]
+	c.i++
+	return v
+}
+
+// chachaBlock computes the ChaCha20 block for in: 20 rounds, then the input is
+// added back in.
+func chachaBlock(out, in *[16]uint32) {
+	x := *in
+	for i := 0; i < 10; i++ {
+		// column rounds
+		quarterRound(&x, 0, 4, 8, 12)
+		quarterRound(&x, 1, 5, 9, 13)
+		quarterRound(&x, 2, 6, 10, 14)
+		quarterRound(&x, 3, 7, 11, 15)
+		// diagonal rounds
+		quarterRound(&x, 0, 5, 10, 15)
+		quarterRound(&x, 1, 6, 11, 12)
+		quarterRound(&x, 2, 7, 8, 13)
+		quarterRound(&x, 3, 4, 9, 14)
+	}
+	for i := range x {
+		out[i] = x[i] + in[i]
+	}
+}
+
+func quarterRound(x *[16]uint32, a, b, c, d int) {
+	x[a] += x[b]
+	x[d] = bits.RotateLeft32(x[d]^x[a], 16)
+	x[c] += x[d]
+	x[b] = bits.RotateLeft32(x[b]^x[c], 12)
+	x[a] += x[b]
+	x[d] = bits.RotateLeft32(x[d]^x[a], 8)
+	x[c] += x[d]
+	x[b] = bits.RotateLeft32(x[b]^x[c], 7)
+}
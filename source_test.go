@@ -0,0 +1,85 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestXorShiftIsDefault(t *testing.T) {
+	m := make([]byte, 2000)
+	rand.Read(m)
+	key := RandomKeyset(3)[0]
+	pr := DefaultParams()
+	pr.Rotation = func() RotationSource { return NewXorShift(pr.Seeds) }
+	if !bytes.Equal(key.Apply(m), pr.Cipher(m, key.Data, key.Invert)) {
+		t.Error("XorShift source does not match the default rotation")
+	}
+}
+
+func TestSplitMix64(t *testing.T) {
+	// the first output for seed 0 is 0xe220a8397b1dcdaf
+	if v := NewSplitMix64(0).Next(); v != 0xe220a839 {
+		t.Errorf("Expected 0xe220a839, got %#x", v)
+	}
+}
+
+func TestChaCha20(t *testing.T) {
+	// RFC 8439 section 2.3.2, the block with counter 1
+	var key [32]byte
+	for i := range key {
+		key[i] = byte(i)
+	}
+	nonce := [12]byte{0, 0, 0, 9, 0, 0, 0, 0x4a}
+	expected := []uint32{
+		0xe4e7f110, 0x15593bd1, 0x1fdd0f50, 0xc47120a3,
+		0xc7f4d1c7, 0x0368c033, 0x9aaa2204, 0x4e6cd4c3,
+		0x466482d2, 0x09aa9f07, 0x05d7c214, 0xa2028bd9,
+		0xd19c12b5, 0xb94e16de, 0xe883d0cb, 0x4e3c50a2,
+	}
+	c := NewChaCha20(key, nonce)
+	for i := 0; i < 16; i++ {
+		c.Next()
+	}
+	for i, e := range expected {
+		if v := c.Next(); v != e {
+			t.Errorf("Word %d: expected %#x, got %#x", i, e, v)
+		}
+	}
+}
+
+func TestRotationSources(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	var key [32]byte
+	for i := range key {
+		key[i] = byte(i * 7)
+	}
+	sources := map[string]func() RotationSource{
+		"splitmix64": func() RotationSource { return NewSplitMix64(12345) },
+		"chacha20":   func() RotationSource { return NewChaCha20(key, [12]byte{}) },
+	}
+	for name, src := range sources {
+		pr := DefaultParams()
+		pr.Rotation = src
+		keys := pr.RandomKeyset(4)
+		if !bytes.Equal(m, pr.CipherMany(m, keys)) {
+			t.Error("Did not cycle with", name)
+		}
+		if bytes.Equal(keys[0].Apply(m), pr.Cipher(m, keys[0].Data, keys[0].Invert)) {
+			t.Error(name, "had no effect")
+		}
+		// seeking restarts the source
+		if !bytes.Equal(pr.Cipher(m, keys[1].Data, keys[1].Invert)[1000:], pr.CipherAt(m[1000:], keys[1], 1000)) {
+			t.Error("CipherAt does not match Cipher with", name)
+		}
+		// keys are tied to the source
+		b, err := pr.EncodeKey(keys[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := defaultParams.DecodeKey(b); err != ErrParams {
+			t.Error("Expected ErrParams for a key made with", name, "got", err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package cyclicKey
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrOffset is returned by the ReaderAt and WriterAt of this package for a
+// negative offset.
+var ErrOffset = errors.New("cyclicKey: negative offset")
+
+// reader applies keys to everything read through it.
+type reader struct {
+	r  io.Reader
+	st *many
+}
+
+// NewReader returns a reader that applies keys to the data read from r. The
+// cipher state is kept across calls to Read, so reading a message in any number
+// of chunks produces the same bytes as a single call to Cipher, or to
+// CipherMany when more than one key is given.
+func NewReader(r io.Reader, keys ...Key) io.Reader {
+	return defaultParams.NewReader(r, keys...)
+}
+
+// NewReader returns a reader that applies keys under the parameter set, see
+// NewReader.
+func (pr *Params) NewReader(r io.Reader, keys ...Key) io.Reader {
+	return &reader{
+		r:  r,
+		st: newMany(pr, keys),
+	}
+}
+
+func (r *reader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	r.st.cipher(b[:n], b[:n])
+	return n, err
+}
+
+// writer applies keys to everything written through it.
+type writer struct {
+	w   io.Writer
+	st  *many
+	buf []byte
+}
+
+// NewWriter returns a writer that applies keys to the data written to it
+// before passing it on to w. The cipher state is kept across calls to Write, so
+// writing a message in any number of chunks produces the same bytes as a single
+// call to Cipher, or to CipherMany when more than one key is given. If w
+// returns an error the state has still advanced past the whole chunk, so the
+// stream cannot be resumed.
+func NewWriter(w io.Writer, keys ...Key) io.Writer {
+	return defaultParams.NewWriter(w, keys...)
+}
+
+// NewWriter returns a writer that applies keys under the parameter set, see
+// NewWriter.
+func (pr *Params) NewWriter(w io.Writer, keys ...Key) io.Writer {
+	return &writer{
+		w:  w,
+		st: newMany(pr, keys),
+	}
+}
+
+func (w *writer) Write(b []byte) (int, error) {
+	if cap(w.buf) < len(b) {
+		w.buf = make([]byte, len(b))
+	}
+	buf := w.buf[:len(b)]
+	w.st.cipher(buf, b)
+	return w.w.Write(buf)
+}
+
+// CipherAt applies key to input as though input started offset bytes into a
+// message. The state at offset is computed directly, so the bytes before it are
+// never touched. This makes it possible to re-cipher one region of a large
+// message. It panics if offset is negative.
+func CipherAt(input []byte, key Key, offset int64) []byte {
+	return defaultParams.CipherAt(input, key, offset)
+}
+
+// CipherAt applies key under the parameter set, see CipherAt.
+func (pr *Params) CipherAt(input []byte, key Key, offset int64) []byte {
+	if offset < 0 {
+		panic("cyclicKey: CipherAt with a negative offset")
+	}
+	output := make([]byte, len(input))
+	st := newState(pr, key.Data, key.Invert)
+	st.seek(offset)
+	st.cipher(output, input)
+	return output
+}
+
+// readerAt applies a key to data read from any offset of r.
+type readerAt struct {
+	pr  *Params
+	r   io.ReaderAt
+	key Key
+}
+
+// NewReaderAt returns an io.ReaderAt that applies key to the data read from r,
+// treating offsets in r as offsets into the message.
+func NewReaderAt(r io.ReaderAt, key Key) io.ReaderAt {
+	return defaultParams.NewReaderAt(r, key)
+}
+
+// NewReaderAt returns an io.ReaderAt that applies key under the parameter set,
+// see NewReaderAt.
+func (pr *Params) NewReaderAt(r io.ReaderAt, key Key) io.ReaderAt {
+	return readerAt{
+		pr:  pr,
+		r:   r,
+		key: key,
+	}
+}
+
+func (r readerAt) ReadAt(b []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, ErrOffset
+	}
+	n, err := r.r.ReadAt(b, off)
+	st := newState(r.pr, r.key.Data, r.key.Invert)
+	st.seek(off)
+	st.cipher(b[:n], b[:n])
+	return n, err
+}
+
+// writerAt applies a key to data written at any offset of w.
+type writerAt struct {
+	pr  *Params
+	w   io.WriterAt
+	key Key
+}
+
+// NewWriterAt returns an io.WriterAt that applies key to the data written to
+// it, treating offsets in w as offsets into the message.
+func NewWriterAt(w io.WriterAt, key Key) io.WriterAt {
+	return defaultParams.NewWriterAt(w, key)
+}
+
+// NewWriterAt returns an io.WriterAt that applies key under the parameter set,
+// see NewWriterAt.
+func (pr *Params) NewWriterAt(w io.WriterAt, key Key) io.WriterAt {
+	return writerAt{
+		pr:  pr,
+		w:   w,
+		key: key,
+	}
+}
+
+func (w writerAt) WriteAt(b []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, ErrOffset
+	}
+	return w.w.WriteAt(w.pr.CipherAt(b, w.key, off), off)
+}
@@ -0,0 +1,147 @@
+package cyclicKey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReaderMatchesCipher(t *testing.T) {
+	m := make([]byte, 5000)
+	rand.Read(m)
+	key := RandomKeyset(3)[0]
+	expected := key.Apply(m)
+
+	// OneByteReader forces the worst case chunking
+	r := NewReader(iotest.OneByteReader(bytes.NewReader(m)), key)
+	got, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, got) {
+		t.Error("Reader output does not match Cipher")
+	}
+}
+
+func TestWriterMatchesCipher(t *testing.T) {
+	m := make([]byte, 5000)
+	rand.Read(m)
+	key := RandomKeyset(3)[1]
+	expected := key.Apply(m)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, key)
+	// write in uneven chunks that straddle the key rotations
+	for i, step := 0, 1; i < len(m); i, step = i+step, step+7 {
+		end := i + step
+		if end > len(m) {
+			end = len(m)
+		}
+		if _, err := w.Write(m[i:end]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(expected, buf.Bytes()) {
+		t.Error("Writer output does not match Cipher")
+	}
+}
+
+func TestStreamCycle(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	var r io.Reader = bytes.NewReader(m)
+	for _, k := range RandomKeyset(4) {
+		r = NewReader(r, k)
+	}
+	got, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(m, got) {
+		t.Error("Did not cycle")
+	}
+}
+
+func TestCipherAt(t *testing.T) {
+	m := make([]byte, 2000)
+	rand.Read(m)
+	for _, kl := range []int{1, 2, 10, 30} {
+		key := Key{Data: make([]byte, kl)}
+		rand.Read(key.Data)
+		expected := key.Apply(m)
+		// offsets on either side of the rotations
+		for _, off := range []int{0, 1, 126 - kl, 127 - kl, 128, 254 - kl, 255 - kl, 1000, 1999} {
+			got := CipherAt(m[off:], key, int64(off))
+			if !bytes.Equal(expected[off:], got) {
+				t.Error("Wrong output at offset", off, "with key length", kl)
+			}
+		}
+	}
+}
+
+func TestCipherAtNegativeOffset(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for a negative offset")
+		}
+	}()
+	CipherAt(make([]byte, 10), RandomKeyset(3)[0], -1)
+}
+
+func TestReaderAt(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	key := RandomKeyset(3)[2]
+	c := key.Apply(m)
+
+	r := NewReaderAt(bytes.NewReader(m), key)
+	b := make([]byte, 300)
+	n, err := r.ReadAt(b, 1234)
+	if err != nil || n != len(b) {
+		t.Fatal(n, err)
+	}
+	if !bytes.Equal(c[1234:1534], b) {
+		t.Error("ReadAt output does not match Cipher")
+	}
+
+	if _, err := r.ReadAt(b, -1); err != ErrOffset {
+		t.Error("Expected ErrOffset, got", err)
+	}
+}
+
+// writerAtBuf is an io.WriterAt over a fixed buffer.
+type writerAtBuf []byte
+
+func (w writerAtBuf) WriteAt(b []byte, off int64) (int, error) {
+	if off < 0 || off+int64(len(b)) > int64(len(w)) {
+		return 0, io.ErrShortWrite
+	}
+	return copy(w[off:], b), nil
+}
+
+func TestWriterAt(t *testing.T) {
+	m := make([]byte, 3000)
+	rand.Read(m)
+	key := RandomKeyset(3)[2]
+	c := key.Apply(m)
+
+	buf := make(writerAtBuf, len(m))
+	w := NewWriterAt(buf, key)
+	// write the message out of order, in uneven pieces
+	for _, r := range [][2]int{{1234, 1534}, {0, 1234}, {2999, 3000}, {1534, 2999}} {
+		n, err := w.WriteAt(m[r[0]:r[1]], int64(r[0]))
+		if err != nil || n != r[1]-r[0] {
+			t.Fatal(n, err)
+		}
+	}
+	if !bytes.Equal(c, buf) {
+		t.Error("WriteAt output does not match Cipher")
+	}
+
+	if _, err := w.WriteAt(m[:10], -1); err != ErrOffset {
+		t.Error("Expected ErrOffset, got", err)
+	}
+}